@@ -0,0 +1,470 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/cmd/commands/internal/table"
+	"github.com/inngest/inngest/pkg/config"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/oklog/ulid/v2"
+	"github.com/spf13/cobra"
+)
+
+var (
+	queueConf    = ""
+	queueLimit   int64
+	queueRequeue string
+	dlqLimit     int64
+	dlqPurgeAll  bool
+)
+
+func NewCmdQueue() *cobra.Command {
+	queueRoot := &cobra.Command{
+		Use:   "queue",
+		Short: "Manages the queue used by self hosted services",
+	}
+	queueRoot.PersistentFlags().StringVarP(&queueConf, "config", "c", "", "The config file location (defaults to ./inngest.(cue|json) or /etc/inngest.(cue|json)")
+
+	partitions := &cobra.Command{
+		Use:   "partitions",
+		Short: "Lists each function's partition, ordered by the time of its next item",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin, err := queueAdmin(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ps, err := admin.Partitions(cmd.Context(), queueLimit)
+			if err != nil {
+				return err
+			}
+
+			t := table.New(table.Row{"Function ID", "Priority", "Next item at", "Items", "Lease"})
+			for _, p := range ps {
+				t.AppendRow(table.Row{
+					p.WorkflowID,
+					p.Priority,
+					p.At.Format(time.RFC3339),
+					p.Len,
+					leaseString(p.LeaseID),
+				})
+			}
+			t.Render()
+			return nil
+		},
+	}
+	partitions.Flags().Int64VarP(&queueLimit, "limit", "l", 100, "The maximum number of partitions to list")
+
+	items := &cobra.Command{
+		Use:   "items [function-id]",
+		Short: "Lists a function's unleased queue items, ordered by the time each item runs",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wid, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("Invalid function ID: %w", err)
+			}
+
+			admin, err := queueAdmin(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			qis, err := admin.PartitionItems(cmd.Context(), wid, queueLimit)
+			if err != nil {
+				return err
+			}
+
+			t := table.New(table.Row{"ID", "Run ID", "Kind", "Attempt", "At"})
+			for _, qi := range qis {
+				t.AppendRow(table.Row{
+					qi.ID,
+					qi.Item.Identifier.RunID,
+					qi.Item.Kind,
+					qi.Item.Attempt,
+					qi.At.Format(time.RFC3339),
+				})
+			}
+			t.Render()
+			return nil
+		},
+	}
+	items.Flags().Int64VarP(&queueLimit, "limit", "l", 100, "The maximum number of items to list")
+
+	leases := &cobra.Command{
+		Use:   "leases",
+		Short: "Lists all leases held by queue workers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin, err := queueAdmin(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ls, err := admin.Leases(cmd.Context(), queueLimit)
+			if err != nil {
+				return err
+			}
+
+			t := table.New(table.Row{"Kind", "ID", "Lease ID", "Expires"})
+			for _, l := range ls {
+				t.AppendRow(table.Row{
+					l.Kind,
+					l.ID,
+					l.LeaseID,
+					l.Expires.Format(time.RFC3339),
+				})
+			}
+			t.Render()
+			return nil
+		},
+	}
+	leases.Flags().Int64VarP(&queueLimit, "limit", "l", 100, "The maximum number of partitions to inspect")
+
+	reprioritize := &cobra.Command{
+		Use:   "reprioritize [function-id] [priority]",
+		Short: "Updates the priority of a function's partition, where 0 is the highest priority",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wid, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("Invalid function ID: %w", err)
+			}
+			priority, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("Invalid priority: %w", err)
+			}
+
+			admin, err := queueAdmin(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if err := admin.ReprioritizePartition(cmd.Context(), wid, uint(priority)); err != nil {
+				return err
+			}
+			fmt.Printf("Updated %s to priority %d\n", wid, priority)
+			return nil
+		},
+	}
+
+	deleteItems := &cobra.Command{
+		Use:   "delete [id...]",
+		Short: "Removes queue items.  Items being processed by workers are not removed.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			admin, err := queueAdmin(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			for _, id := range args {
+				if err := admin.DeleteItem(cmd.Context(), id); err != nil {
+					return fmt.Errorf("error deleting %s: %w", id, err)
+				}
+				fmt.Printf("Deleted %s\n", id)
+			}
+			return nil
+		},
+	}
+
+	requeueItems := &cobra.Command{
+		Use:   "requeue [id...]",
+		Short: "Reschedules queue items.  Items being processed by workers are not rescheduled.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			at := time.Now()
+			if queueRequeue != "" {
+				var err error
+				if at, err = time.Parse(time.RFC3339, queueRequeue); err != nil {
+					return fmt.Errorf("Invalid time: %w", err)
+				}
+			}
+
+			admin, err := queueAdmin(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			for _, id := range args {
+				if err := admin.RequeueItem(cmd.Context(), id, at); err != nil {
+					return fmt.Errorf("error requeueing %s: %w", id, err)
+				}
+				fmt.Printf("Requeued %s\n", id)
+			}
+			return nil
+		},
+	}
+	requeueItems.Flags().StringVar(&queueRequeue, "at", "", "The RFC3339 time to run the items at (defaults to now)")
+
+	pause := &cobra.Command{
+		Use:   "pause [function-id...]",
+		Short: "Pauses functions.  New items are enqueued but not processed until resumed.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pauser, err := functionPauser(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			for _, arg := range args {
+				wid, err := uuid.Parse(arg)
+				if err != nil {
+					return fmt.Errorf("Invalid function ID: %w", err)
+				}
+				if err := pauser.PauseFunction(cmd.Context(), wid); err != nil {
+					return fmt.Errorf("error pausing %s: %w", wid, err)
+				}
+				fmt.Printf("Paused %s\n", wid)
+			}
+			return nil
+		},
+	}
+
+	resume := &cobra.Command{
+		Use:   "resume [function-id...]",
+		Short: "Resumes paused functions, processing their items in order",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pauser, err := functionPauser(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			for _, arg := range args {
+				wid, err := uuid.Parse(arg)
+				if err != nil {
+					return fmt.Errorf("Invalid function ID: %w", err)
+				}
+				if err := pauser.ResumeFunction(cmd.Context(), wid); err != nil {
+					return fmt.Errorf("error resuming %s: %w", wid, err)
+				}
+				fmt.Printf("Resumed %s\n", wid)
+			}
+			return nil
+		},
+	}
+
+	paused := &cobra.Command{
+		Use:   "paused",
+		Short: "Lists paused functions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pauser, err := functionPauser(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ids, err := pauser.PausedFunctions(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			t := table.New(table.Row{"Function ID"})
+			for _, id := range ids {
+				t.AppendRow(table.Row{id})
+			}
+			t.Render()
+			return nil
+		},
+	}
+
+	dlqRoot := &cobra.Command{
+		Use:   "dlq",
+		Short: "Manages permanently failed queue items within the dead-letter queue",
+	}
+
+	dlqList := &cobra.Command{
+		Use:   "list",
+		Short: "Lists dead-lettered items, most recently failed first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dlq, err := deadLetterQueue(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			dls, err := dlq.DeadLetters(cmd.Context(), dlqLimit)
+			if err != nil {
+				return err
+			}
+
+			t := table.New(table.Row{"ID", "Function ID", "Kind", "Attempt", "Failed at", "Error"})
+			for _, dl := range dls {
+				kind := ""
+				if dl.Item != nil {
+					kind = dl.Item.Kind
+				}
+				t.AppendRow(table.Row{
+					dl.ID,
+					dl.WorkflowID,
+					kind,
+					dl.Attempt,
+					dl.FailedAt.Format(time.RFC3339),
+					dl.Error,
+				})
+			}
+			t.Render()
+			return nil
+		},
+	}
+	dlqList.Flags().Int64VarP(&dlqLimit, "limit", "l", 100, "The maximum number of items to list")
+
+	dlqInspect := &cobra.Command{
+		Use:   "inspect [id]",
+		Short: "Shows a dead-lettered item, including its error and payload",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dlq, err := deadLetterQueue(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			dl, err := dlq.DeadLetter(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			byt, err := json.MarshalIndent(dl, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(byt))
+			return nil
+		},
+	}
+
+	dlqRequeue := &cobra.Command{
+		Use:   "requeue [id...]",
+		Short: "Enqueues dead-lettered items to run immediately, resetting their attempts",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dlq, err := deadLetterQueue(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			for _, id := range args {
+				if err := dlq.RequeueDeadLetter(cmd.Context(), id); err != nil {
+					return fmt.Errorf("error requeueing %s: %w", id, err)
+				}
+				fmt.Printf("Requeued %s\n", id)
+			}
+			return nil
+		},
+	}
+
+	dlqPurge := &cobra.Command{
+		Use:   "purge [id...]",
+		Short: "Removes dead-lettered items.  Use --all to remove every item.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && !dlqPurgeAll {
+				return fmt.Errorf("No items specified.  Specify item IDs or use --all")
+			}
+			if len(args) > 0 && dlqPurgeAll {
+				return fmt.Errorf("Specify either item IDs or --all, not both")
+			}
+
+			dlq, err := deadLetterQueue(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			n, err := dlq.PurgeDeadLetters(cmd.Context(), args...)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Purged %d items\n", n)
+			return nil
+		},
+	}
+	dlqPurge.Flags().BoolVar(&dlqPurgeAll, "all", false, "Remove every dead-lettered item")
+
+	dlqRoot.AddCommand(dlqList)
+	dlqRoot.AddCommand(dlqInspect)
+	dlqRoot.AddCommand(dlqRequeue)
+	dlqRoot.AddCommand(dlqPurge)
+	queueRoot.AddCommand(partitions)
+	queueRoot.AddCommand(items)
+	queueRoot.AddCommand(leases)
+	queueRoot.AddCommand(reprioritize)
+	queueRoot.AddCommand(deleteItems)
+	queueRoot.AddCommand(requeueItems)
+	queueRoot.AddCommand(pause)
+	queueRoot.AddCommand(resume)
+	queueRoot.AddCommand(paused)
+	queueRoot.AddCommand(dlqRoot)
+
+	return queueRoot
+}
+
+// deadLetterQueue returns the dead-letter queue for the queue configured within
+// the self hosted config file.
+func deadLetterQueue(ctx context.Context) (queue.DeadLetterQueue, error) {
+	q, backend, err := loadQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dlq, ok := q.(queue.DeadLetterQueue)
+	if !ok {
+		return nil, fmt.Errorf("The %s queue does not support dead letters", backend)
+	}
+	return dlq, nil
+}
+
+// queueAdmin returns the admin API for the queue configured within the self hosted
+// config file.
+func queueAdmin(ctx context.Context) (queue.Admin, error) {
+	q, backend, err := loadQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, ok := q.(queue.Admin)
+	if !ok {
+		return nil, fmt.Errorf("The %s queue does not support administration", backend)
+	}
+	return admin, nil
+}
+
+func functionPauser(ctx context.Context) (queue.FunctionPauser, error) {
+	q, backend, err := loadQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pauser, ok := q.(queue.FunctionPauser)
+	if !ok {
+		return nil, fmt.Errorf("The %s queue does not support pausing functions", backend)
+	}
+	return pauser, nil
+}
+
+// loadQueue returns the queue configured within the self hosted config file, and
+// the name of the queue's backend.
+func loadQueue(ctx context.Context) (queue.Queue, string, error) {
+	locs := []string{}
+	if queueConf != "" {
+		locs = []string{queueConf}
+	}
+	conf, err := config.Load(ctx, locs...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	q, err := conf.Queue.Service.Concrete.Queue()
+	if err != nil {
+		return nil, "", err
+	}
+	return q, conf.Queue.Service.Backend, nil
+}
+
+func leaseString(leaseID *ulid.ULID) string {
+	if leaseID == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (until %s)", leaseID, ulid.Time(leaseID.Time()).Format(time.RFC3339))
+}
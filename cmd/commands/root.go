@@ -76,6 +76,8 @@ func Execute() {
 	rootCmd.AddCommand(NewCmdDev())
 	rootCmd.AddCommand(NewCmdVersion())
 	rootCmd.AddCommand(NewCmdServe())
+	rootCmd.AddCommand(NewCmdQueue())
+	rootCmd.AddCommand(NewCmdRuns())
 	rootCmd.AddCommand(NewCmdSteps())
 	rootCmd.AddCommand(NewCmdTypes())
 
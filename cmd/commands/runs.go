@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/config"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/runner"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+	"github.com/spf13/cobra"
+)
+
+var (
+	runsConf     = ""
+	runsFunction string
+	runsFrom     string
+	runsTo       string
+	runsFromStep string
+)
+
+func NewCmdRuns() *cobra.Command {
+	runsRoot := &cobra.Command{
+		Use:   "runs",
+		Short: "Manages function runs within self hosted services",
+	}
+	runsRoot.PersistentFlags().StringVarP(&runsConf, "config", "c", "", "The config file location (defaults to ./inngest.(cue|json) or /etc/inngest.(cue|json)")
+
+	cancel := &cobra.Command{
+		Use:   "cancel [run-id...]",
+		Short: "Cancels function runs.  Use --function to cancel all of a function's running runs.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && runsFunction == "" {
+				return fmt.Errorf("No runs specified.  Specify run IDs or use --function")
+			}
+			if len(args) > 0 && runsFunction != "" {
+				return fmt.Errorf("Specify either run IDs or --function, not both")
+			}
+
+			conf, err := loadRunsConfig(cmd.Context())
+			if err != nil {
+				return err
+			}
+			sm, err := conf.State.Service.Concrete.Manager(cmd.Context())
+			if err != nil {
+				return err
+			}
+			q, err := conf.Queue.Service.Concrete.Queue()
+			if err != nil {
+				return err
+			}
+
+			if runsFunction != "" {
+				return cancelFunctionRuns(cmd.Context(), sm, q)
+			}
+
+			for _, arg := range args {
+				runID, err := ulid.Parse(arg)
+				if err != nil {
+					return fmt.Errorf("Invalid run ID: %w", err)
+				}
+				if err := runner.CancelRun(cmd.Context(), sm, q, runID); err != nil {
+					return fmt.Errorf("error cancelling %s: %w", runID, err)
+				}
+				fmt.Printf("Cancelled %s\n", runID)
+			}
+			return nil
+		},
+	}
+	cancel.Flags().StringVar(&runsFunction, "function", "", "The ID of the function whose running runs are cancelled")
+	cancel.Flags().StringVar(&runsFrom, "from", "", "The RFC3339 time from which runs started are cancelled, used with --function")
+	cancel.Flags().StringVar(&runsTo, "to", "", "The RFC3339 time before which runs started are cancelled, used with --function (defaults to now)")
+
+	rerun := &cobra.Command{
+		Use:   "rerun [run-id]",
+		Short: "Creates a new run of a function run using the run's original event",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runID, err := ulid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("Invalid run ID: %w", err)
+			}
+
+			conf, err := loadRunsConfig(cmd.Context())
+			if err != nil {
+				return err
+			}
+			sm, err := conf.State.Service.Concrete.Manager(cmd.Context())
+			if err != nil {
+				return err
+			}
+			q, err := conf.Queue.Service.Concrete.Queue()
+			if err != nil {
+				return err
+			}
+
+			id, err := runner.Rerun(cmd.Context(), sm, q, runID, runsFromStep)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Created run %s\n", id.RunID)
+			return nil
+		},
+	}
+	rerun.Flags().StringVar(&runsFromStep, "from-step", "", "The step to rerun from, copying the outputs of preceding steps (defaults to rerunning the entire function)")
+
+	runsRoot.AddCommand(cancel)
+	runsRoot.AddCommand(rerun)
+
+	return runsRoot
+}
+
+// cancelFunctionRuns cancels the function's runs started within the time range
+// given by the --from and --to flags.
+func cancelFunctionRuns(ctx context.Context, sm state.Manager, q queue.Producer) error {
+	wid, err := uuid.Parse(runsFunction)
+	if err != nil {
+		return fmt.Errorf("Invalid function ID: %w", err)
+	}
+	if runsFrom == "" {
+		return fmt.Errorf("No start time specified.  Use --from to cancel a function's runs")
+	}
+	from, err := time.Parse(time.RFC3339, runsFrom)
+	if err != nil {
+		return fmt.Errorf("Invalid time: %w", err)
+	}
+	to := time.Now()
+	if runsTo != "" {
+		if to, err = time.Parse(time.RFC3339, runsTo); err != nil {
+			return fmt.Errorf("Invalid time: %w", err)
+		}
+	}
+
+	n, err := runner.CancelRuns(ctx, sm, q, wid, from, to)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Cancelled %d runs\n", n)
+	return nil
+}
+
+// loadRunsConfig returns the self hosted config.
+func loadRunsConfig(ctx context.Context) (*config.Config, error) {
+	locs := []string{}
+	if runsConf != "" {
+		locs = []string{runsConf}
+	}
+	return config.Load(ctx, locs...)
+}
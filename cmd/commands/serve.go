@@ -10,6 +10,7 @@ import (
 	"github.com/inngest/inngest/pkg/coreapi"
 	"github.com/inngest/inngest/pkg/execution/executor"
 	"github.com/inngest/inngest/pkg/execution/runner"
+	metrics "github.com/inngest/inngest/pkg/metrics/server"
 	"github.com/inngest/inngest/pkg/service"
 	"github.com/spf13/cobra"
 
@@ -25,8 +26,9 @@ const (
 )
 
 var (
-	serveConf = ""
-	serveArgs = []string{ServeExecutor, ServeRunner, ServeEventAPI, ServeCoreAPI}
+	serveConf        = ""
+	serveMetricsPort = 0
+	serveArgs        = []string{ServeExecutor, ServeRunner, ServeEventAPI, ServeCoreAPI}
 )
 
 func NewCmdServe() *cobra.Command {
@@ -40,6 +42,7 @@ func NewCmdServe() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&serveConf, "config", "c", "", "The config file location (defaults to ./inngest.(cue|json) or /etc/inngest.(cue|json)")
+	cmd.Flags().IntVar(&serveMetricsPort, "metrics-port", 0, "Serve metrics on the given port, overriding the config")
 
 	return cmd
 }
@@ -66,6 +69,11 @@ func serve(cmd *cobra.Command, args []string) {
 		os.Exit(1)
 	}
 
+	if serveMetricsPort > 0 {
+		conf.Metrics.Enabled = true
+		conf.Metrics.Port = serveMetricsPort
+	}
+
 	svc := []service.Service{}
 	for _, name := range args {
 		switch name {
@@ -83,6 +91,11 @@ func serve(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if conf.Metrics.Enabled {
+		// Serve metrics for all components started within this process.
+		svc = append(svc, metrics.NewService(*conf))
+	}
+
 	if err := service.StartAll(ctx, svc...); err != nil {
 		fmt.Println(err.Error())
 		os.Exit(1)
@@ -64,6 +64,15 @@ func (g Graph) From(id string) []GraphEdge {
 	return edges
 }
 
+func (g Graph) To(id string) []GraphEdge {
+	ifaces := g.EdgesTo(LookupVertex{ID: id})
+	edges := make([]GraphEdge, len(ifaces))
+	for n, i := range ifaces {
+		edges[n] = i.(GraphEdge)
+	}
+	return edges
+}
+
 type LookupVertex struct {
 	ID string
 }
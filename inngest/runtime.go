@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/xhit/go-str2duration/v2"
 )
 
 const (
@@ -82,6 +85,11 @@ func (RuntimeDocker) RuntimeType() string {
 
 type RuntimeHTTP struct {
 	URL string `json:"url"`
+	// ConnectTimeout is the maximum duration for connecting to the URL, eg. "5s".
+	ConnectTimeout *string `json:"connectTimeout,omitempty"`
+	// ResponseTimeout is the maximum duration to wait for a response after
+	// sending each request, eg. "30s".
+	ResponseTimeout *string `json:"responseTimeout,omitempty"`
 }
 
 // MarshalJSON implements the JSON marshal interface so that cue can format this
@@ -91,9 +99,31 @@ func (r RuntimeHTTP) MarshalJSON() ([]byte, error) {
 		"type": RuntimeTypeHTTP,
 		"url":  r.URL,
 	}
+	if r.ConnectTimeout != nil {
+		data["connectTimeout"] = *r.ConnectTimeout
+	}
+	if r.ResponseTimeout != nil {
+		data["responseTimeout"] = *r.ResponseTimeout
+	}
 	return json.Marshal(data)
 }
 
+// Timeouts returns the runtime's connect and response timeouts.  Each timeout is
+// zero if unset.
+func (r RuntimeHTTP) Timeouts() (connect time.Duration, response time.Duration, err error) {
+	if r.ConnectTimeout != nil {
+		if connect, err = str2duration.ParseDuration(*r.ConnectTimeout); err != nil {
+			return 0, 0, fmt.Errorf("invalid connect timeout: %w", err)
+		}
+	}
+	if r.ResponseTimeout != nil {
+		if response, err = str2duration.ParseDuration(*r.ResponseTimeout); err != nil {
+			return 0, 0, fmt.Errorf("invalid response timeout: %w", err)
+		}
+	}
+	return connect, response, nil
+}
+
 func (RuntimeHTTP) RuntimeType() string {
 	return RuntimeTypeHTTP
 }
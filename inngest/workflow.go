@@ -1,8 +1,13 @@
 package inngest
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/backoff"
 	"github.com/inngest/inngest/pkg/consts"
+	"github.com/xhit/go-str2duration/v2"
 )
 
 const (
@@ -32,19 +37,23 @@ type Workflow struct {
 	//
 	// When deploying a specific workflow version we read the cue configuration
 	// and upsert a version to the given ID.
-	ID       string    `json:"id"`
-	Name     string    `json:"name"`
-	Throttle *Throttle `json:"throttle,omitempty"`
-	Triggers []Trigger `json:"triggers"`
-	Steps    []Step    `json:"actions"`
-	Edges    []Edge    `json:"edges"`
-	Cancel   []Cancel  `json:"cancel,omitempty"`
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Throttle    *Throttle    `json:"throttle,omitempty"`
+	Concurrency *Concurrency `json:"concurrency,omitempty"`
+	Debounce    *Debounce    `json:"debounce,omitempty"`
+	Triggers    []Trigger    `json:"triggers"`
+	Steps       []Step       `json:"actions"`
+	Edges       []Edge       `json:"edges"`
+	Cancel      []Cancel     `json:"cancel,omitempty"`
+	Timeouts    *Timeouts    `json:"timeouts,omitempty"`
 }
 
 type Throttle struct {
 	// Count is how often the function can be called within the specified period
 	Count uint `json:"count"`
-	// Period represents the time period for throttling the function
+	// Period represents the time period for throttling the function, as a
+	// whole number of seconds (eg. "30s" or "1m").
 	Period string `json:"period"`
 	// Key is an optional string to constrain throttling using event data.  For
 	// example, if you want to throttle incoming notifications based off of a user's
@@ -53,6 +62,31 @@ type Throttle struct {
 	Key *string `json:"key"`
 }
 
+// Concurrency limits the number of steps for a function which can run at
+// the same time.
+type Concurrency struct {
+	// Limit is the maximum number of steps which can run concurrently.
+	Limit uint `json:"limit"`
+	// Key is an optional expression to constrain concurrency using event data.
+	// For example, if you want to limit concurrency for each account you can use
+	// the following key: "event.data.account_id".  Each evaluated key has its own
+	// limit.
+	Key *string `json:"key,omitempty"`
+}
+
+// Debounce delays a workflow's runs until matching events stop being received
+// for the given period, running once with the last event received.
+type Debounce struct {
+	// Period is the period of silence after the last event before the workflow
+	// runs, eg. "30s".  Each new event pushes back the pending run.
+	Period string `json:"period"`
+	// Key is an optional expression to debounce runs using event data.  For
+	// example, if you want to debounce runs for each user you can use the
+	// following key: "event.user.id".  Each evaluated key is debounced
+	// independently.
+	Key *string `json:"key,omitempty"`
+}
+
 // Trigger represents the starting point for a workflow
 type Trigger struct {
 	*EventTrigger
@@ -61,8 +95,23 @@ type Trigger struct {
 
 // EventTrigger represents an event that triggers this workflow.
 type EventTrigger struct {
-	Event      string  `json:"event"`
-	Expression *string `json:"expression"`
+	Event      string      `json:"event"`
+	Expression *string     `json:"expression"`
+	Batch      *EventBatch `json:"batch,omitempty"`
+}
+
+// EventBatch configures a trigger to invoke the workflow with a batch of
+// matching events instead of a single event.
+type EventBatch struct {
+	// MaxSize is the maximum number of events within a single batch.  A run
+	// starts as soon as the batch is full.
+	MaxSize int `json:"maxSize"`
+	// Timeout is how long to wait for a batch to fill, eg. "5s".  A run starts
+	// with a partial batch once this elapses after the first event is received.
+	Timeout string `json:"timeout"`
+	// Key is an optional expression to accumulate separate batches using event
+	// data, eg. "event.data.account_id".
+	Key *string `json:"key,omitempty"`
 }
 
 // CronTrigger represents the cron schedule that triggers this workflow
@@ -84,6 +133,8 @@ type Step struct {
 	Version  *VersionConstraint     `json:"version,omitempty"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	Retries  *RetryOptions          `json:"retries,omitempty"`
+	// Timeout is the maximum duration of each attempt of the step, eg. "30s".
+	Timeout *string `json:"timeout,omitempty"`
 	// Cancel specifies cancellation signals for the function
 	Cancel []Cancel `json:"cancel,omitempty"`
 }
@@ -97,9 +148,41 @@ func (s Step) RetryCount() int {
 	return consts.DefaultRetryCount
 }
 
+// Backoff returns the backoff policy for retrying this step, or nil if the step
+// uses the default backoff.
+func (s Step) Backoff() (*backoff.Policy, error) {
+	if s.Retries == nil || s.Retries.Backoff == nil {
+		return nil, nil
+	}
+	return s.Retries.Backoff.Policy()
+}
+
+// TimeoutDuration returns the maximum duration of each attempt of the step, or
+// zero if the step doesn't specify a timeout.
+func (s Step) TimeoutDuration() (time.Duration, error) {
+	if s.Timeout == nil {
+		return 0, nil
+	}
+	return str2duration.ParseDuration(*s.Timeout)
+}
+
+// Step returns the step with the given ID, or nil if the workflow has no such step.
+func (w Workflow) Step(id string) *Step {
+	for n := range w.Steps {
+		if w.Steps[n].ID == id {
+			return &w.Steps[n]
+		}
+	}
+	return nil
+}
+
 type Edge struct {
 	Outgoing string `json:"outgoing"`
 	Incoming string `json:"incoming"`
+	// IncomingGeneratorStep is the ID of the planned generator step to run
+	// when invoking the incoming SDK-based step.  This is set when steps
+	// are planned to run in parallel, and is empty otherwise.
+	IncomingGeneratorStep string `json:"gen,omitempty"`
 	// Metadata specifies the type of edge to use.  This defaults
 	// to EdgeTypeEdge - a basic link that can conditionally run.
 	Metadata *EdgeMetadata `json:"metadata,omitempty"`
@@ -111,7 +194,11 @@ type EdgeMetadata struct {
 	// Wait specifies that the edge should only be traversed after the specified
 	// duration.  This, in effect, allows you to delay jobs for a given amount of
 	// time.
-	Wait               *string `json:"wait,omitempty"`
+	Wait *string `json:"wait,omitempty"`
+	// Join lists every step which must complete, or be skipped, before the
+	// incoming step runs.  Each of the steps has its own edge to the incoming
+	// step, and the incoming step runs once after all edges are traversed.
+	Join               []string `json:"join,omitempty"`
 	*AsyncEdgeMetadata `json:"async,omitempty"`
 }
 
@@ -142,6 +229,92 @@ type VersionConstraint struct {
 type RetryOptions struct {
 	// Attempts is the maximum number of times to retry.
 	Attempts *int `json:"attempts,omitempty"`
+	// Backoff configures the delay between each retry.  If nil, retries use
+	// an exponential backoff starting at 10 seconds.
+	Backoff *Backoff `json:"backoff,omitempty"`
+}
+
+// Backoff configures the delay between each retry of a step.
+type Backoff struct {
+	// Type is the backoff type:  "exponential", "fixed" or "schedule".
+	Type string `json:"type"`
+	// Base is the delay before the first retry for exponential backoffs, which
+	// doubles for each subsequent retry, eg. "10s".
+	Base *string `json:"base,omitempty"`
+	// Max is the maximum delay between retries, eg. "1h".
+	Max *string `json:"max,omitempty"`
+	// Jitter adds up to the given fraction of each delay at random, eg. 0.15.
+	Jitter *float64 `json:"jitter,omitempty"`
+	// Interval is the delay before every retry for fixed backoffs, eg. "30s".
+	Interval *string `json:"interval,omitempty"`
+	// Schedule is the delay before each retry for schedule backoffs, eg.
+	// ["10s", "1m", "10m"].  The last delay is used once the schedule is
+	// exhausted.
+	Schedule []string `json:"schedule,omitempty"`
+}
+
+// Policy parses the backoff configuration into a backoff policy.
+func (b Backoff) Policy() (*backoff.Policy, error) {
+	p := &backoff.Policy{Kind: b.Type}
+
+	var err error
+	if b.Max != nil {
+		if p.Max, err = str2duration.ParseDuration(*b.Max); err != nil {
+			return nil, fmt.Errorf("invalid backoff max: %w", err)
+		}
+	}
+	if b.Jitter != nil {
+		if *b.Jitter < 0 {
+			return nil, fmt.Errorf("backoff jitter must not be negative")
+		}
+		p.Jitter = *b.Jitter
+	}
+
+	switch b.Type {
+	case backoff.KindExponential:
+		p.Base = 10 * time.Second
+		if b.Base != nil {
+			if p.Base, err = str2duration.ParseDuration(*b.Base); err != nil {
+				return nil, fmt.Errorf("invalid backoff base: %w", err)
+			}
+		}
+	case backoff.KindFixed:
+		if b.Interval == nil {
+			return nil, fmt.Errorf("a fixed backoff must specify an interval")
+		}
+		if p.Base, err = str2duration.ParseDuration(*b.Interval); err != nil {
+			return nil, fmt.Errorf("invalid backoff interval: %w", err)
+		}
+	case backoff.KindSchedule:
+		if len(b.Schedule) == 0 {
+			return nil, fmt.Errorf("a schedule backoff must specify at least one delay")
+		}
+		p.Schedule = make([]time.Duration, len(b.Schedule))
+		for n, d := range b.Schedule {
+			if p.Schedule[n], err = str2duration.ParseDuration(d); err != nil {
+				return nil, fmt.Errorf("invalid backoff schedule: %w", err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown backoff type: %s", b.Type)
+	}
+
+	return p, nil
+}
+
+// Timeouts ends runs which exceed the given durations.  Runs are cancelled once
+// either timeout is exceeded.
+//
+// Timeouts are enqueued within the function's own queue partition, so they're
+// only checked once the partition is processed:  while a partition is paused or
+// backlogged, runs may be cancelled some time after their timeout.
+type Timeouts struct {
+	// Run is the maximum duration of each run, eg. "2h".
+	Run *string `json:"run,omitempty"`
+	// Start is the maximum duration between a run being created and its first
+	// step starting, eg. "10m".  This limits the time spent waiting behind the
+	// function's concurrency limits.
+	Start *string `json:"start,omitempty"`
 }
 
 // Cancel represents a cancellation signal for a function.  When specified, this
@@ -0,0 +1,76 @@
+package backoff
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	// KindExponential doubles the delay for each attempt, starting at the
+	// policy's base delay.
+	KindExponential = "exponential"
+	// KindFixed uses the policy's base delay for every attempt.
+	KindFixed = "fixed"
+	// KindSchedule uses an explicit delay for each attempt, repeating the
+	// last delay once the schedule is exhausted.
+	KindSchedule = "schedule"
+)
+
+// Policy is a backoff policy for retrying queue items.  This is stored within
+// queue items so that every queue implementation computes retries the same way.
+type Policy struct {
+	// Kind is the type of backoff:  one of KindExponential, KindFixed or
+	// KindSchedule.
+	Kind string `json:"k"`
+	// Base is the delay for the first attempt of exponential policies, or the
+	// delay for every attempt of fixed policies.
+	Base time.Duration `json:"b,omitempty"`
+	// Max caps the delay between attempts, if greater than zero.
+	Max time.Duration `json:"m,omitempty"`
+	// Jitter adds up to the given fraction of each delay at random, eg. 0.15
+	// adds between 0 and 15% to each delay.
+	Jitter float64 `json:"j,omitempty"`
+	// Schedule is the delay for each attempt of schedule policies.
+	Schedule []time.Duration `json:"s,omitempty"`
+}
+
+// At returns the time at which the given attempt should run.  Attempts are
+// one-indexed, as they're always a retry of the zero-indexed first attempt.
+func (p Policy) At(attemptNum int) time.Time {
+	return time.Now().Add(p.Delay(attemptNum))
+}
+
+// Delay returns the delay before running the given attempt.
+func (p Policy) Delay(attemptNum int) time.Duration {
+	if attemptNum < 1 {
+		attemptNum = 1
+	}
+
+	var delay float64
+	switch p.Kind {
+	case KindFixed:
+		delay = float64(p.Base)
+	case KindSchedule:
+		if len(p.Schedule) == 0 {
+			return 0
+		}
+		n := attemptNum - 1
+		if n >= len(p.Schedule) {
+			n = len(p.Schedule) - 1
+		}
+		delay = float64(p.Schedule[n])
+	default:
+		delay = float64(p.Base) * math.Pow(2, float64(attemptNum-1))
+	}
+
+	delay += delay * p.Jitter * rand.Float64()
+	if p.Max > 0 && delay > float64(p.Max) {
+		return p.Max
+	}
+	// Guard against overflowing durations for large attempt counts.
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
@@ -0,0 +1,46 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	t.Run("Exponential policies double each delay", func(t *testing.T) {
+		p := Policy{Kind: KindExponential, Base: time.Second}
+		require.Equal(t, time.Second, p.Delay(1))
+		require.Equal(t, 2*time.Second, p.Delay(2))
+		require.Equal(t, 8*time.Second, p.Delay(4))
+	})
+
+	t.Run("Delays are capped at the max", func(t *testing.T) {
+		p := Policy{Kind: KindExponential, Base: time.Second, Max: 5 * time.Second}
+		require.Equal(t, 4*time.Second, p.Delay(3))
+		require.Equal(t, 5*time.Second, p.Delay(4))
+		require.Equal(t, 5*time.Second, p.Delay(100))
+	})
+
+	t.Run("Fixed policies use the same delay", func(t *testing.T) {
+		p := Policy{Kind: KindFixed, Base: 30 * time.Second}
+		require.Equal(t, 30*time.Second, p.Delay(1))
+		require.Equal(t, 30*time.Second, p.Delay(5))
+	})
+
+	t.Run("Schedule policies repeat the last delay", func(t *testing.T) {
+		p := Policy{Kind: KindSchedule, Schedule: []time.Duration{time.Second, time.Minute}}
+		require.Equal(t, time.Second, p.Delay(1))
+		require.Equal(t, time.Minute, p.Delay(2))
+		require.Equal(t, time.Minute, p.Delay(3))
+	})
+
+	t.Run("Jitter adds up to the given fraction", func(t *testing.T) {
+		p := Policy{Kind: KindFixed, Base: 10 * time.Second, Jitter: 0.5}
+		for i := 0; i < 100; i++ {
+			d := p.Delay(1)
+			require.GreaterOrEqual(t, d, 10*time.Second)
+			require.LessOrEqual(t, d, 15*time.Second)
+		}
+	})
+}
@@ -65,6 +65,8 @@ type Config struct {
 	State State
 	// DataStore configures the persisted data for the system
 	DataStore DataStore
+	// Metrics configures the prometheus metrics endpoint.
+	Metrics Metrics
 }
 
 // Log configures the logger used within Inngest services.
@@ -95,6 +97,20 @@ type CoreAPI struct {
 	Port int
 }
 
+// Metrics configures the prometheus metrics endpoint served by each service.
+type Metrics struct {
+	// Enabled enables the metrics endpoint, defaulting to false.  Each process
+	// serving metrics must use its own port, which can be set via the
+	// --metrics-port flag when serving.
+	Enabled bool
+	// Addr is the IP to bind to, eg. "0.0.0.0" or "127.0.0.1"
+	Addr string
+	// Port is the port to use, defaulting to 9300.
+	Port int
+	// Path is the HTTP path metrics are served on, defaulting to "/metrics".
+	Path string
+}
+
 // EventAPI configures the event stream, which connects events to the execution engine.
 type EventStream struct {
 	Service MessagingService
@@ -6,11 +6,17 @@ import (
 
 	"github.com/inngest/inngest/pkg/config/registration"
 	inmemorydatastore "github.com/inngest/inngest/pkg/coredata/inmemory"
+	sqlitedatastore "github.com/inngest/inngest/pkg/coredata/sqlite"
 	"github.com/inngest/inngest/pkg/execution/driver/dockerdriver"
 	"github.com/inngest/inngest/pkg/execution/driver/httpdriver"
 	"github.com/inngest/inngest/pkg/execution/queue/inmemoryqueue"
+	"github.com/inngest/inngest/pkg/execution/queue/postgresqueue"
+	"github.com/inngest/inngest/pkg/execution/queue/sqlitequeue"
+	"github.com/inngest/inngest/pkg/execution/queue/sqlqueue"
 	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/execution/state/postgres_state"
 	"github.com/inngest/inngest/pkg/execution/state/redis_state"
+	"github.com/inngest/inngest/pkg/execution/state/sqlite_state"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,6 +66,12 @@ func defaultConfig() *Config {
 				Concrete: &inmemorydatastore.Config{},
 			},
 		},
+		Metrics: Metrics{
+			Enabled: false,
+			Addr:    "0.0.0.0",
+			Port:    9300,
+			Path:    "/metrics",
+		},
 	}
 
 	return base
@@ -113,6 +125,19 @@ config.#Config & {
 				return c
 			},
 		},
+		{
+			name: "overriding metrics",
+			input: []byte(`{
+	metrics: { enabled: true, port: 9400 }
+}
+`),
+			config: func() *Config {
+				c := defaultConfig()
+				c.Metrics.Enabled = true
+				c.Metrics.Port = 9400
+				return c
+			},
+		},
 		{
 			name: "nats config, as cue",
 			input: []byte(`package main
@@ -190,6 +215,149 @@ config.#Config & {
 				}, redis)
 			},
 		},
+		{
+			name: "postgres state config",
+			input: []byte(`package main
+
+import (
+	config "inngest.com/defs/config"
+)
+
+config.#Config & {
+  state: {
+    service: {
+      backend: "postgres"
+      URI: "postgres://${TEST_ENV}:5432/inngest"
+    }
+  }
+}
+`),
+			config: func() *Config {
+				c := defaultConfig()
+				c.State.Service.Backend = "postgres"
+				c.State.Service.Concrete = &postgres_state.Config{
+					URI:          "postgres://test-env:5432/inngest",
+					MaxOpenConns: 20,
+				}
+				return c
+			},
+		},
+		{
+			name: "redis queue config",
+			input: []byte(`package main
+
+import (
+	config "inngest.com/defs/config"
+)
+
+config.#Config & {
+  queue: {
+    service: {
+      backend: "redis"
+      host: "${TEST_ENV}"
+      numWorkers: 25
+    }
+  }
+}
+`),
+			config: func() *Config {
+				c := defaultConfig()
+				c.Queue.Service.Backend = "redis"
+				c.Queue.Service.Concrete = &redis_state.QueueConfig{
+					Host:           "test-env",
+					Port:           6379,
+					KeyPrefix:      "inngest:queue",
+					NumWorkers:     25,
+					PollTick:       "10ms",
+					IdempotencyTTL: "12h",
+				}
+				return c
+			},
+		},
+		{
+			name: "postgres queue config",
+			input: []byte(`package main
+
+import (
+	config "inngest.com/defs/config"
+)
+
+config.#Config & {
+  queue: {
+    service: {
+      backend: "postgres"
+      URI: "postgres://${TEST_ENV}:5432/inngest"
+      maxJobDuration: "10m"
+    }
+  }
+}
+`),
+			config: func() *Config {
+				c := defaultConfig()
+				c.Queue.Service.Backend = "postgres"
+				c.Queue.Service.Concrete = &postgresqueue.Config{
+					URI:          "postgres://test-env:5432/inngest",
+					MaxOpenConns: 20,
+					Options: sqlqueue.Options{
+						NumWorkers:     100,
+						PollTick:       "100ms",
+						IdempotencyTTL: "12h",
+						MaxJobDuration: "10m",
+					},
+				}
+				return c
+			},
+		},
+		{
+			name: "sqlite config",
+			input: []byte(`package main
+
+import (
+	config "inngest.com/defs/config"
+)
+
+config.#Config & {
+  datastore: {
+    service: {
+      backend: "sqlite"
+      path: "/${TEST_ENV}/inngest.db"
+    }
+  }
+  queue: {
+    service: {
+      backend: "sqlite"
+      path: "/${TEST_ENV}/inngest.db"
+    }
+  }
+  state: {
+    service: {
+      backend: "sqlite"
+    }
+  }
+}
+`),
+			config: func() *Config {
+				c := defaultConfig()
+				c.DataStore.Service.Backend = "sqlite"
+				c.DataStore.Service.Concrete = &sqlitedatastore.Config{
+					Path: "/test-env/inngest.db",
+				}
+				c.Queue.Service.Backend = "sqlite"
+				c.Queue.Service.Concrete = &sqlitequeue.Config{
+					Path: "/test-env/inngest.db",
+					Options: sqlqueue.Options{
+						NumWorkers:     100,
+						PollTick:       "100ms",
+						IdempotencyTTL: "12h",
+					},
+				}
+				c.State.Service.Backend = "sqlite"
+				c.State.Service.Concrete = &sqlite_state.Config{
+					Path: "inngest.db",
+				}
+				return c
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -13,6 +13,7 @@ import (
 	"github.com/inngest/inngest/pkg/coreapi/generated"
 	"github.com/inngest/inngest/pkg/coreapi/graph/resolvers"
 	"github.com/inngest/inngest/pkg/coredata"
+	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/runner"
 	"github.com/rs/zerolog"
 )
@@ -21,7 +22,9 @@ type Options struct {
 	Config        config.Config
 	Logger        *zerolog.Logger
 	APIReadWriter coredata.APIReadWriter
+	Loader        coredata.ExecutionLoader
 	Runner        runner.Runner
+	Queue         queue.Queue
 }
 
 func NewCoreApi(o Options) (*CoreAPI, error) {
@@ -43,7 +46,9 @@ func NewCoreApi(o Options) (*CoreAPI, error) {
 
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{
 		APIReadWriter: o.APIReadWriter,
+		Loader:        o.Loader,
 		Runner:        o.Runner,
+		Queue:         o.Queue,
 	}}))
 
 	// TODO - Add option for enabling GraphQL Playground
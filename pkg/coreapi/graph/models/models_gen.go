@@ -19,6 +19,16 @@ type ActionVersionQuery struct {
 	VersionMinor *int   `json:"versionMinor"`
 }
 
+type CancelFunctionRunInput struct {
+	FunctionRunID string `json:"functionRunId"`
+}
+
+type CancelFunctionRunsInput struct {
+	FunctionID string     `json:"functionId"`
+	From       time.Time  `json:"from"`
+	To         *time.Time `json:"to"`
+}
+
 type Config struct {
 	Execution *ExecutionConfig `json:"execution"`
 }
@@ -27,6 +37,30 @@ type CreateActionVersionInput struct {
 	Config string `json:"config"`
 }
 
+type DeadLetter struct {
+	ID            string     `json:"id"`
+	FunctionID    string     `json:"functionId"`
+	FunctionRunID *string    `json:"functionRunId"`
+	Kind          *string    `json:"kind"`
+	Error         string     `json:"error"`
+	Attempt       int        `json:"attempt"`
+	Payload       *string    `json:"payload"`
+	At            *time.Time `json:"at"`
+	FailedAt      time.Time  `json:"failedAt"`
+}
+
+type DeadLetterQuery struct {
+	DeadLetterID string `json:"deadLetterId"`
+}
+
+type DeadLettersQuery struct {
+	Limit *int `json:"limit"`
+}
+
+type DeleteQueueItemInput struct {
+	QueueItemID string `json:"queueItemId"`
+}
+
 type DeployFunctionInput struct {
 	Env    *Environment `json:"env"`
 	Config string       `json:"config"`
@@ -70,6 +104,13 @@ type ExecutionDriversConfig struct {
 	Docker *ExecutionDockerDriverConfig `json:"docker"`
 }
 
+type Function struct {
+	ID     string `json:"id"`
+	Slug   string `json:"slug"`
+	Name   string `json:"name"`
+	Paused bool   `json:"paused"`
+}
+
 type FunctionEvent struct {
 	Workspace   *Workspace         `json:"workspace"`
 	FunctionRun *FunctionRun       `json:"functionRun"`
@@ -81,15 +122,16 @@ type FunctionEvent struct {
 func (FunctionEvent) IsFunctionRunEvent() {}
 
 type FunctionRun struct {
-	ID           string             `json:"id"`
-	Name         *string            `json:"name"`
-	Workspace    *Workspace         `json:"workspace"`
-	Status       *FunctionRunStatus `json:"status"`
-	WaitingFor   *StepEventWait     `json:"waitingFor"`
-	PendingSteps *int               `json:"pendingSteps"`
-	StartedAt    *time.Time         `json:"startedAt"`
-	Timeline     []FunctionRunEvent `json:"timeline"`
-	Event        *Event             `json:"event"`
+	ID            string             `json:"id"`
+	Name          *string            `json:"name"`
+	Workspace     *Workspace         `json:"workspace"`
+	Status        *FunctionRunStatus `json:"status"`
+	WaitingFor    *StepEventWait     `json:"waitingFor"`
+	PendingSteps  *int               `json:"pendingSteps"`
+	StartedAt     *time.Time         `json:"startedAt"`
+	Timeline      []FunctionRunEvent `json:"timeline"`
+	Event         *Event             `json:"event"`
+	OriginalRunID *string            `json:"originalRunId"`
 }
 
 type FunctionRunQuery struct {
@@ -101,6 +143,76 @@ type FunctionRunsQuery struct {
 	WorkspaceID string `json:"workspaceId"`
 }
 
+type PauseFunctionInput struct {
+	FunctionID string `json:"functionId"`
+}
+
+type PurgeDeadLettersInput struct {
+	DeadLetterIds []string `json:"deadLetterIds"`
+}
+
+type QueueItem struct {
+	ID            string    `json:"id"`
+	FunctionID    string    `json:"functionId"`
+	FunctionRunID string    `json:"functionRunId"`
+	Kind          string    `json:"kind"`
+	Attempt       int       `json:"attempt"`
+	At            time.Time `json:"at"`
+	Payload       string    `json:"payload"`
+	LeaseID       *string   `json:"leaseId"`
+}
+
+type QueueItemsQuery struct {
+	FunctionID string `json:"functionId"`
+	Limit      *int   `json:"limit"`
+}
+
+type QueueLease struct {
+	Kind    string    `json:"kind"`
+	ID      *string   `json:"id"`
+	LeaseID string    `json:"leaseId"`
+	Expires time.Time `json:"expires"`
+}
+
+type QueueLeasesQuery struct {
+	Limit *int `json:"limit"`
+}
+
+type QueuePartition struct {
+	FunctionID string    `json:"functionId"`
+	Priority   int       `json:"priority"`
+	At         time.Time `json:"at"`
+	Len        int       `json:"len"`
+	LeaseID    *string   `json:"leaseId"`
+}
+
+type QueuePartitionsQuery struct {
+	Limit *int `json:"limit"`
+}
+
+type ReprioritizeQueuePartitionInput struct {
+	FunctionID string `json:"functionId"`
+	Priority   int    `json:"priority"`
+}
+
+type RequeueDeadLetterInput struct {
+	DeadLetterID string `json:"deadLetterId"`
+}
+
+type RequeueQueueItemInput struct {
+	QueueItemID string     `json:"queueItemId"`
+	At          *time.Time `json:"at"`
+}
+
+type RerunFunctionRunInput struct {
+	FunctionRunID string  `json:"functionRunId"`
+	FromStep      *string `json:"fromStep"`
+}
+
+type ResumeFunctionInput struct {
+	FunctionID string `json:"functionId"`
+}
+
 type StepEvent struct {
 	Workspace   *Workspace     `json:"workspace"`
 	FunctionRun *FunctionRun   `json:"functionRun"`
@@ -187,6 +299,7 @@ const (
 	FunctionEventTypeCompleted FunctionEventType = "COMPLETED"
 	FunctionEventTypeFailed    FunctionEventType = "FAILED"
 	FunctionEventTypeCancelled FunctionEventType = "CANCELLED"
+	FunctionEventTypeSkipped   FunctionEventType = "SKIPPED"
 )
 
 var AllFunctionEventType = []FunctionEventType{
@@ -194,11 +307,12 @@ var AllFunctionEventType = []FunctionEventType{
 	FunctionEventTypeCompleted,
 	FunctionEventTypeFailed,
 	FunctionEventTypeCancelled,
+	FunctionEventTypeSkipped,
 }
 
 func (e FunctionEventType) IsValid() bool {
 	switch e {
-	case FunctionEventTypeStarted, FunctionEventTypeCompleted, FunctionEventTypeFailed, FunctionEventTypeCancelled:
+	case FunctionEventTypeStarted, FunctionEventTypeCompleted, FunctionEventTypeFailed, FunctionEventTypeCancelled, FunctionEventTypeSkipped:
 		return true
 	}
 	return false
@@ -232,6 +346,7 @@ const (
 	FunctionRunStatusFailed    FunctionRunStatus = "FAILED"
 	FunctionRunStatusCancelled FunctionRunStatus = "CANCELLED"
 	FunctionRunStatusRunning   FunctionRunStatus = "RUNNING"
+	FunctionRunStatusSkipped   FunctionRunStatus = "SKIPPED"
 )
 
 var AllFunctionRunStatus = []FunctionRunStatus{
@@ -239,11 +354,12 @@ var AllFunctionRunStatus = []FunctionRunStatus{
 	FunctionRunStatusFailed,
 	FunctionRunStatusCancelled,
 	FunctionRunStatusRunning,
+	FunctionRunStatusSkipped,
 }
 
 func (e FunctionRunStatus) IsValid() bool {
 	switch e {
-	case FunctionRunStatusCompleted, FunctionRunStatusFailed, FunctionRunStatusCancelled, FunctionRunStatusRunning:
+	case FunctionRunStatusCompleted, FunctionRunStatusFailed, FunctionRunStatusCancelled, FunctionRunStatusRunning, FunctionRunStatusSkipped:
 		return true
 	}
 	return false
@@ -279,6 +395,7 @@ const (
 	StepEventTypeErrored   StepEventType = "ERRORED"
 	StepEventTypeFailed    StepEventType = "FAILED"
 	StepEventTypeWaiting   StepEventType = "WAITING"
+	StepEventTypeTimedOut  StepEventType = "TIMED_OUT"
 )
 
 var AllStepEventType = []StepEventType{
@@ -288,11 +405,12 @@ var AllStepEventType = []StepEventType{
 	StepEventTypeErrored,
 	StepEventTypeFailed,
 	StepEventTypeWaiting,
+	StepEventTypeTimedOut,
 }
 
 func (e StepEventType) IsValid() bool {
 	switch e {
-	case StepEventTypeScheduled, StepEventTypeStarted, StepEventTypeCompleted, StepEventTypeErrored, StepEventTypeFailed, StepEventTypeWaiting:
+	case StepEventTypeScheduled, StepEventTypeStarted, StepEventTypeCompleted, StepEventTypeErrored, StepEventTypeFailed, StepEventTypeWaiting, StepEventTypeTimedOut:
 		return true
 	}
 	return false
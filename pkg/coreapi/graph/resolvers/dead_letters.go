@@ -0,0 +1,120 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/execution/queue"
+)
+
+func (r *queryResolver) DeadLetter(ctx context.Context, query models.DeadLetterQuery) (*models.DeadLetter, error) {
+	dlq, err := r.deadLetterQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	dl, err := dlq.DeadLetter(ctx, query.DeadLetterID)
+	if errors.Is(err, queue.ErrDeadLetterNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return deadLetter(*dl)
+}
+
+func (r *queryResolver) DeadLetters(ctx context.Context, query models.DeadLettersQuery) ([]*models.DeadLetter, error) {
+	dlq, err := r.deadLetterQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(0)
+	if query.Limit != nil {
+		limit = int64(*query.Limit)
+	}
+
+	dls, err := dlq.DeadLetters(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*models.DeadLetter{}
+	for _, dl := range dls {
+		m, err := deadLetter(dl)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (r *mutationResolver) RequeueDeadLetter(ctx context.Context, input models.RequeueDeadLetterInput) (*bool, error) {
+	dlq, err := r.deadLetterQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dlq.RequeueDeadLetter(ctx, input.DeadLetterID); err != nil {
+		return nil, err
+	}
+	ok := true
+	return &ok, nil
+}
+
+func (r *mutationResolver) PurgeDeadLetters(ctx context.Context, input models.PurgeDeadLettersInput) (*int, error) {
+	dlq, err := r.deadLetterQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := dlq.PurgeDeadLetters(ctx, input.DeadLetterIds...)
+	if err != nil {
+		return nil, err
+	}
+	purged := int(n)
+	return &purged, nil
+}
+
+// deadLetterQueue returns the queue's dead-letter queue, if the configured queue
+// stores permanently failed items.
+func (r *Resolver) deadLetterQueue() (queue.DeadLetterQueue, error) {
+	dlq, ok := r.Queue.(queue.DeadLetterQueue)
+	if !ok {
+		return nil, fmt.Errorf("the configured queue does not support dead letters")
+	}
+	return dlq, nil
+}
+
+func deadLetter(dl queue.DeadLetter) (*models.DeadLetter, error) {
+	at := dl.At
+	m := &models.DeadLetter{
+		ID:         dl.ID,
+		FunctionID: dl.WorkflowID.String(),
+		Error:      dl.Error,
+		Attempt:    dl.Attempt,
+		At:         &at,
+		FailedAt:   dl.FailedAt,
+	}
+
+	if dl.Item == nil {
+		// The item couldn't be read, so return the item as it was stored.
+		m.Payload = &dl.Raw
+		return m, nil
+	}
+
+	byt, err := json.Marshal(dl.Item)
+	if err != nil {
+		return nil, err
+	}
+	payload := string(byt)
+	runID := dl.Item.Identifier.RunID.String()
+	m.Payload = &payload
+	m.FunctionRunID = &runID
+	m.Kind = &dl.Item.Kind
+	return m, nil
+}
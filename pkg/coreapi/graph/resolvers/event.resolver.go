@@ -29,13 +29,11 @@ func (r *eventResolver) FunctionRuns(ctx context.Context, obj *models.Event) ([]
 			status = models.FunctionRunStatusFailed
 		case enums.RunStatusCancelled:
 			status = models.FunctionRunStatusCancelled
+		case enums.RunStatusSkipped:
+			status = models.FunctionRunStatusSkipped
 		}
 
-		var startedAt time.Time
-
-		if m.OriginalRunID != nil {
-			startedAt = time.UnixMilli(int64(m.OriginalRunID.Time()))
-		}
+		startedAt := time.UnixMilli(int64(m.Identifier.RunID.Time()))
 
 		name := string(m.Name)
 		pending := int(m.Pending)
@@ -46,11 +44,12 @@ func (r *eventResolver) FunctionRuns(ctx context.Context, obj *models.Event) ([]
 		}
 
 		runs = append(runs, &models.FunctionRun{
-			ID:           m.OriginalRunID.String(),
-			Name:         &name,
-			Status:       &status,
-			PendingSteps: &pending,
-			StartedAt:    &startedAt,
+			ID:            m.Identifier.RunID.String(),
+			Name:          &name,
+			Status:        &status,
+			PendingSteps:  &pending,
+			StartedAt:     &startedAt,
+			OriginalRunID: originalRunID(m),
 		})
 	}
 
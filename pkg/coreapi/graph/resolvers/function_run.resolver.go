@@ -3,11 +3,14 @@ package resolvers
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/inngest/inngest/pkg/coreapi/graph/models"
 	"github.com/inngest/inngest/pkg/enums"
 	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/runner"
 	"github.com/inngest/inngest/pkg/execution/state"
 	"github.com/oklog/ulid/v2"
 )
@@ -97,6 +100,14 @@ func (r *functionRunResolver) Event(ctx context.Context, obj *models.FunctionRun
 			startEvent = &h
 			break
 		}
+		if h.Type == enums.HistoryTypeFunctionSkipped {
+			// Skipped runs store the triggering event within the history data.
+			if data, ok := h.Data.(state.HistoryFunctionSkipped); ok {
+				h.Data = data.Event
+			}
+			startEvent = &h
+			break
+		}
 	}
 
 	if startEvent == nil {
@@ -160,7 +171,7 @@ func (r *functionRunResolver) WaitingFor(ctx context.Context, obj *models.Functi
 }
 
 func isFunctionEvent(h enums.HistoryType) bool {
-	return h == enums.HistoryTypeFunctionStarted || h == enums.HistoryTypeFunctionCompleted || h == enums.HistoryTypeFunctionCancelled || h == enums.HistoryTypeFunctionFailed
+	return h == enums.HistoryTypeFunctionStarted || h == enums.HistoryTypeFunctionCompleted || h == enums.HistoryTypeFunctionCancelled || h == enums.HistoryTypeFunctionFailed || h == enums.HistoryTypeFunctionSkipped
 }
 
 func functionEventEnum(h enums.HistoryType) models.FunctionEventType {
@@ -171,6 +182,8 @@ func functionEventEnum(h enums.HistoryType) models.FunctionEventType {
 		return models.FunctionEventTypeCancelled
 	case enums.HistoryTypeFunctionFailed:
 		return models.FunctionEventTypeFailed
+	case enums.HistoryTypeFunctionSkipped:
+		return models.FunctionEventTypeSkipped
 	}
 
 	return models.FunctionEventTypeStarted
@@ -188,7 +201,89 @@ func stepEventEnum(h enums.HistoryType) models.StepEventType {
 		return models.StepEventTypeFailed
 	case enums.HistoryTypeStepWaiting:
 		return models.StepEventTypeWaiting
+	case enums.HistoryTypeStepTimedOut:
+		return models.StepEventTypeTimedOut
 	}
 
 	return models.StepEventTypeScheduled
 }
+
+func (r *mutationResolver) CancelFunctionRun(ctx context.Context, input models.CancelFunctionRunInput) (*bool, error) {
+	runID, err := ulid.Parse(input.FunctionRunID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function run ID: %w", err)
+	}
+	rn, err := r.runner()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rn.CancelRun(ctx, runID); err != nil {
+		return nil, err
+	}
+	ok := true
+	return &ok, nil
+}
+
+func (r *mutationResolver) CancelFunctionRuns(ctx context.Context, input models.CancelFunctionRunsInput) (*int, error) {
+	functionID, err := uuid.Parse(input.FunctionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function ID: %w", err)
+	}
+
+	to := time.Now()
+	if input.To != nil {
+		to = *input.To
+	}
+	rn, err := r.runner()
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := rn.CancelRuns(ctx, functionID, input.From, to)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (r *mutationResolver) RerunFunctionRun(ctx context.Context, input models.RerunFunctionRunInput) (*string, error) {
+	runID, err := ulid.Parse(input.FunctionRunID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function run ID: %w", err)
+	}
+
+	fromStep := ""
+	if input.FromStep != nil {
+		fromStep = *input.FromStep
+	}
+	rn, err := r.runner()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := rn.Rerun(ctx, runID, fromStep)
+	if err != nil {
+		return nil, err
+	}
+	newRunID := id.RunID.String()
+	return &newRunID, nil
+}
+
+// runner returns the API's runner, which manages function runs.  This is unset
+// when the API is served without a runner, eg. via `inngest serve`.
+func (r *Resolver) runner() (runner.Runner, error) {
+	if r.Runner == nil {
+		return nil, fmt.Errorf("the API is not configured to manage function runs")
+	}
+	return r.Runner, nil
+}
+
+// originalRunID returns the ID of the run that the given run was rerun from.
+func originalRunID(m state.Metadata) *string {
+	if m.OriginalRunID == nil {
+		return nil
+	}
+	id := m.OriginalRunID.String()
+	return &id
+}
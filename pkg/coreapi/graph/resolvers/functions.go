@@ -25,7 +25,7 @@ func (r *queryResolver) FunctionRun(ctx context.Context, query models.FunctionRu
 	var targetRun *state.Metadata
 
 	for _, m := range metadata {
-		if m.OriginalRunID.String() == query.FunctionRunID {
+		if m.Identifier.RunID.String() == query.FunctionRunID {
 			targetRun = &m
 			break
 		}
@@ -46,11 +46,7 @@ func (r *queryResolver) FunctionRun(ctx context.Context, query models.FunctionRu
 		status = models.FunctionRunStatusCancelled
 	}
 
-	var startedAt time.Time
-
-	if targetRun.OriginalRunID != nil {
-		startedAt = time.UnixMilli(int64(targetRun.OriginalRunID.Time()))
-	}
+	startedAt := time.UnixMilli(int64(targetRun.Identifier.RunID.Time()))
 
 	name := string(targetRun.Name)
 	pending := int(targetRun.Pending)
@@ -61,11 +57,12 @@ func (r *queryResolver) FunctionRun(ctx context.Context, query models.FunctionRu
 	}
 
 	return &models.FunctionRun{
-		ID:           targetRun.OriginalRunID.String(),
-		Name:         &name,
-		Status:       &status,
-		PendingSteps: &pending,
-		StartedAt:    &startedAt,
+		ID:            targetRun.Identifier.RunID.String(),
+		Name:          &name,
+		Status:        &status,
+		PendingSteps:  &pending,
+		StartedAt:     &startedAt,
+		OriginalRunID: originalRunID(*targetRun),
 	}, nil
 }
 
@@ -89,11 +86,7 @@ func (r *queryResolver) FunctionRuns(ctx context.Context, query models.FunctionR
 			status = models.FunctionRunStatusCancelled
 		}
 
-		var startedAt time.Time
-
-		if m.OriginalRunID != nil {
-			startedAt = time.UnixMilli(int64(m.OriginalRunID.Time()))
-		}
+		startedAt := time.UnixMilli(int64(m.Identifier.RunID.Time()))
 
 		name := string(m.Name)
 		pending := int(m.Pending)
@@ -104,11 +97,12 @@ func (r *queryResolver) FunctionRuns(ctx context.Context, query models.FunctionR
 		}
 
 		runs = append(runs, &models.FunctionRun{
-			ID:           m.OriginalRunID.String(),
-			Name:         &name,
-			Status:       &status,
-			PendingSteps: &pending,
-			StartedAt:    &startedAt,
+			ID:            m.Identifier.RunID.String(),
+			Name:          &name,
+			Status:        &status,
+			PendingSteps:  &pending,
+			StartedAt:     &startedAt,
+			OriginalRunID: originalRunID(m),
 		})
 	}
 
@@ -0,0 +1,120 @@
+package resolvers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/function"
+)
+
+func (r *queryResolver) Functions(ctx context.Context) ([]*models.Function, error) {
+	if r.Loader == nil {
+		return nil, fmt.Errorf("functions are not available")
+	}
+
+	fns, err := r.Loader.Functions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paused := map[uuid.UUID]bool{}
+	if pauser, ok := r.Queue.(queue.FunctionPauser); ok {
+		ids, err := pauser.PausedFunctions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			paused[id] = true
+		}
+	}
+
+	result := []*models.Function{}
+	for _, fn := range fns {
+		id, err := workflowID(ctx, fn)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &models.Function{
+			ID:     id.String(),
+			Slug:   fn.Slug(),
+			Name:   fn.Name,
+			Paused: paused[id],
+		})
+	}
+	return result, nil
+}
+
+func (r *mutationResolver) PauseFunction(ctx context.Context, input models.PauseFunctionInput) (*models.Function, error) {
+	return r.setPaused(ctx, input.FunctionID, true)
+}
+
+func (r *mutationResolver) ResumeFunction(ctx context.Context, input models.ResumeFunctionInput) (*models.Function, error) {
+	return r.setPaused(ctx, input.FunctionID, false)
+}
+
+func (r *mutationResolver) setPaused(ctx context.Context, functionID string, paused bool) (*models.Function, error) {
+	pauser, ok := r.Queue.(queue.FunctionPauser)
+	if !ok {
+		return nil, fmt.Errorf("the configured queue does not support pausing functions")
+	}
+	if r.Loader == nil {
+		return nil, fmt.Errorf("functions are not available")
+	}
+
+	wid, err := uuid.Parse(functionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function ID: %w", err)
+	}
+
+	fns, err := r.Loader.Functions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fn := range fns {
+		id, err := workflowID(ctx, fn)
+		if err != nil {
+			return nil, err
+		}
+		if id != wid {
+			continue
+		}
+
+		if paused {
+			err = pauser.PauseFunction(ctx, wid)
+		} else {
+			err = pauser.ResumeFunction(ctx, wid)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.Function{
+			ID:     id.String(),
+			Slug:   fn.Slug(),
+			Name:   fn.Name,
+			Paused: paused,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("function not found")
+}
+
+// workflowID returns the ID used for the function within the queue and state store,
+// matching the ID that the runner uses when initializing new runs.
+func workflowID(ctx context.Context, fn function.Function) (uuid.UUID, error) {
+	flow, err := fn.Workflow(ctx)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	zero := uuid.UUID{}
+	if bytes.Equal(flow.UUID[:], zero[:]) {
+		return function.DeterministicUUID(fn), nil
+	}
+	return flow.UUID, nil
+}
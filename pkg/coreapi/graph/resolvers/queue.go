@@ -0,0 +1,176 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/coreapi/graph/models"
+	"github.com/inngest/inngest/pkg/execution/queue"
+)
+
+func (r *queryResolver) QueuePartitions(ctx context.Context, query models.QueuePartitionsQuery) ([]*models.QueuePartition, error) {
+	admin, err := r.queueAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	partitions, err := admin.Partitions(ctx, limit(query.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*models.QueuePartition{}
+	for _, p := range partitions {
+		m := &models.QueuePartition{
+			FunctionID: p.WorkflowID.String(),
+			Priority:   int(p.Priority),
+			At:         p.At,
+			Len:        int(p.Len),
+		}
+		if p.LeaseID != nil {
+			leaseID := p.LeaseID.String()
+			m.LeaseID = &leaseID
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (r *queryResolver) QueueItems(ctx context.Context, query models.QueueItemsQuery) ([]*models.QueueItem, error) {
+	admin, err := r.queueAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	wid, err := uuid.Parse(query.FunctionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function ID: %w", err)
+	}
+
+	items, err := admin.PartitionItems(ctx, wid, limit(query.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*models.QueueItem{}
+	for _, qi := range items {
+		byt, err := json.Marshal(qi.Item)
+		if err != nil {
+			return nil, err
+		}
+		m := &models.QueueItem{
+			ID:            qi.ID,
+			FunctionID:    qi.WorkflowID.String(),
+			FunctionRunID: qi.Item.Identifier.RunID.String(),
+			Kind:          qi.Item.Kind,
+			Attempt:       qi.Item.Attempt,
+			At:            qi.At,
+			Payload:       string(byt),
+		}
+		if qi.LeaseID != nil {
+			leaseID := qi.LeaseID.String()
+			m.LeaseID = &leaseID
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (r *queryResolver) QueueLeases(ctx context.Context, query models.QueueLeasesQuery) ([]*models.QueueLease, error) {
+	admin, err := r.queueAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	leases, err := admin.Leases(ctx, limit(query.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*models.QueueLease{}
+	for _, l := range leases {
+		m := &models.QueueLease{
+			Kind:    l.Kind,
+			LeaseID: l.LeaseID.String(),
+			Expires: l.Expires,
+		}
+		if l.ID != "" {
+			id := l.ID
+			m.ID = &id
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func (r *mutationResolver) ReprioritizeQueuePartition(ctx context.Context, input models.ReprioritizeQueuePartitionInput) (*bool, error) {
+	admin, err := r.queueAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	wid, err := uuid.Parse(input.FunctionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid function ID: %w", err)
+	}
+	if input.Priority < 0 {
+		return nil, fmt.Errorf("priority must be positive")
+	}
+
+	if err := admin.ReprioritizePartition(ctx, wid, uint(input.Priority)); err != nil {
+		return nil, err
+	}
+	ok := true
+	return &ok, nil
+}
+
+func (r *mutationResolver) DeleteQueueItem(ctx context.Context, input models.DeleteQueueItemInput) (*bool, error) {
+	admin, err := r.queueAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := admin.DeleteItem(ctx, input.QueueItemID); err != nil {
+		return nil, err
+	}
+	ok := true
+	return &ok, nil
+}
+
+func (r *mutationResolver) RequeueQueueItem(ctx context.Context, input models.RequeueQueueItemInput) (*bool, error) {
+	admin, err := r.queueAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	at := time.Now()
+	if input.At != nil {
+		at = *input.At
+	}
+
+	if err := admin.RequeueItem(ctx, input.QueueItemID, at); err != nil {
+		return nil, err
+	}
+	ok := true
+	return &ok, nil
+}
+
+// queueAdmin returns the queue's admin API, if the configured queue supports
+// inspection and modification.
+func (r *Resolver) queueAdmin() (queue.Admin, error) {
+	admin, ok := r.Queue.(queue.Admin)
+	if !ok {
+		return nil, fmt.Errorf("the configured queue does not support administration")
+	}
+	return admin, nil
+}
+
+func limit(l *int) int64 {
+	if l == nil {
+		return 0
+	}
+	return int64(*l)
+}
@@ -5,12 +5,15 @@ package resolvers
 import (
 	"github.com/inngest/inngest/pkg/coreapi/generated"
 	"github.com/inngest/inngest/pkg/coredata"
+	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/runner"
 )
 
 type Resolver struct {
 	APIReadWriter coredata.APIReadWriter
+	Loader        coredata.ExecutionLoader
 	Runner        runner.Runner
+	Queue         queue.Queue
 }
 
 // Mutation returns generated.MutationResolver implementation.
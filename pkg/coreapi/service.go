@@ -7,6 +7,7 @@ import (
 
 	"github.com/inngest/inngest/pkg/config"
 	"github.com/inngest/inngest/pkg/coredata"
+	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/runner"
 	"github.com/inngest/inngest/pkg/logger"
 	"github.com/inngest/inngest/pkg/service"
@@ -34,13 +35,25 @@ func WithRunner(r runner.Runner) Opt {
 	}
 }
 
+// WithExecutionLoader sets the loader used to list functions.  This defaults to
+// the configured datastore.
+func WithExecutionLoader(l coredata.ExecutionLoader) Opt {
+	return func(s *svc) {
+		s.loader = l
+	}
+}
+
 type svc struct {
 	config config.Config
 	api    *CoreAPI
 	// data provides the ability to write and load data
 	data coredata.APIReadWriter
+	// loader lists the available functions
+	loader coredata.ExecutionLoader
 	// runner is the execution runner
 	runner runner.Runner
+	// queue is the execution queue, used to manage permanently failed items
+	queue queue.Queue
 }
 
 func (s *svc) Name() string {
@@ -48,7 +61,16 @@ func (s *svc) Name() string {
 }
 
 func (s *svc) Pre(ctx context.Context) (err error) {
-	s.data, err = s.config.DataStore.Service.Concrete.ReadWriter(ctx)
+	rw, err := s.config.DataStore.Service.Concrete.ReadWriter(ctx)
+	if err != nil {
+		return err
+	}
+	s.data = rw
+	if s.loader == nil {
+		s.loader = rw
+	}
+
+	s.queue, err = s.config.Queue.Service.Concrete.Queue()
 	if err != nil {
 		return err
 	}
@@ -58,7 +80,9 @@ func (s *svc) Pre(ctx context.Context) (err error) {
 		Config:        s.config,
 		Logger:        logger.From(ctx),
 		APIReadWriter: s.data,
+		Loader:        s.loader,
 		Runner:        s.runner,
+		Queue:         s.queue,
 	})
 
 	if err != nil {
@@ -0,0 +1,62 @@
+-- The SQLite data store schema, adapted from the Postgres data store's
+-- migrations.
+
+-- action_versions are individual versions of runnable actions.
+-- actions are steps that run as part of a step function.
+-- once published/enabled, they cannot be overwritten.
+CREATE TABLE IF NOT EXISTS action_versions (
+  action_dsn text NOT NULL,
+  version_major integer NOT NULL,
+  version_minor integer NOT NULL,
+  -- cue configuration
+  config text NOT NULL,
+  -- container image sha256
+  image_sha256 text,
+  -- valid date range marks when the action has been enabled
+  valid_from timestamp,
+  valid_to timestamp,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (action_dsn, version_major, version_minor)
+);
+
+-- functions are named functions that have n number of versions
+CREATE TABLE IF NOT EXISTS functions (
+  function_id text NOT NULL,
+  name text NOT NULL,
+  PRIMARY KEY (function_id)
+);
+
+-- function_versions is a store of immutable configurations for a given function.
+-- config is a serialized cue configuration instructing how the step function should run
+-- and which actions/action_versions to run.
+-- only one function currently can be live (valid) at a current time.
+CREATE TABLE IF NOT EXISTS function_versions (
+  function_id text NOT NULL REFERENCES functions (function_id) ON DELETE CASCADE,
+  version integer NOT NULL,
+  -- cue configuration
+  config text NOT NULL,
+  -- valid date range marks when the version is "live"
+  valid_from timestamp,
+  valid_to timestamp,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (function_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS function_versions_valid ON function_versions (valid_from, valid_to, function_id);
+
+-- function_triggers is used to query for matching functions when an event is received
+CREATE TABLE IF NOT EXISTS function_triggers (
+  id integer PRIMARY KEY AUTOINCREMENT,
+  function_id text NOT NULL,
+  -- the matching function_version
+  version integer NOT NULL,
+  event_name text,
+  schedule text,
+  expression text,
+  FOREIGN KEY (function_id, version) REFERENCES function_versions (function_id, version) ON DELETE CASCADE
+);
+
+-- indexes to match by trigger
+CREATE INDEX IF NOT EXISTS function_triggers_event_name_function_id ON function_triggers (event_name, function_id) WHERE event_name IS NOT NULL;
+CREATE INDEX IF NOT EXISTS function_triggers_schedule_function_id ON function_triggers (schedule, function_id) WHERE schedule IS NOT NULL;
@@ -27,6 +27,15 @@ package config
 		port: >0 & <=65535 | *8300
 	}
 
+	// Metrics is used to configure the prometheus metrics endpoint, served
+	// by each service started via "inngest serve".
+	metrics: {
+		enabled: bool | *false
+		addr:    string | *"0.0.0.0"
+		port:    >0 & <=65535 | *9300
+		path:    string | *"/metrics"
+	}
+
 	execution: {
 		// Enable drivers for given runtimes within this array.  The key
 		// is the runtime name specified within steps of a function, and
@@ -114,7 +123,7 @@ package config
 // # Queues
 //
 
-#QueueService: #InmemQueue | #SQSQueue | #RedisQueue
+#QueueService: #InmemQueue | #SQSQueue | #RedisQueue | #PostgresQueue | #SqliteQueue
 
 #InmemQueue: {
 	// This uses the Redis driver with an in-memory redis instance
@@ -136,7 +145,8 @@ package config
 	concurrency: >=1 | *10
 }
 
-// RedisState uses Redis as the backend state store.
+// RedisQueue uses Redis as the backing queue.  This is a durable queue which
+// can be shared between many runners and executors.
 #RedisQueue: {
 	backend: "redis"
 
@@ -153,14 +163,84 @@ package config
 	poolSize?:   >=1
 
 	// keyPrefix is the prefix used for all redis keys stored
-	keyPrefix: string | *""
+	keyPrefix: string | *"inngest:queue"
+
+	// numWorkers specifies how many concurrent queue items - and therefore
+	// function steps - can be handled in parallel by each process.
+	numWorkers: >=1 | *100
+
+	// pollTick is the interval between each scan for available jobs, as a
+	// duration (eg. "10ms").
+	pollTick: string | *"10ms"
+
+	// idempotencyTTL is how long a dequeued job ID is remembered, preventing
+	// jobs with the same ID from being enqueued again within this period.
+	idempotencyTTL: string | *"12h"
+
+	// maxJobDuration is the default maximum time that each job can run for, as
+	// a duration (eg. "10m").  Jobs which run for longer are cancelled and
+	// retried.  If unset, jobs can run indefinitely.
+	maxJobDuration?: string
+}
+
+// PostgresQueue uses PostgreSQL as the backing queue.  This is a durable queue
+// which can be shared between many runners and executors, allowing small
+// deployments to use a single database for both the queue and state.
+#PostgresQueue: {
+	backend: "postgres"
+	URI:     string | *"postgres://localhost:5432/postgres?sslmode=disable"
+
+	// maxOpenConns is the maximum number of open connections to the database.
+	maxOpenConns: >=1 | *20
+
+	// numWorkers specifies how many concurrent queue items - and therefore
+	// function steps - can be handled in parallel by each process.
+	numWorkers: >=1 | *100
+
+	// pollTick is the interval between each scan for available jobs, as a
+	// duration (eg. "100ms").
+	pollTick: string | *"100ms"
+
+	// idempotencyTTL is how long a dequeued job ID is remembered, preventing
+	// jobs with the same ID from being enqueued again within this period.
+	idempotencyTTL: string | *"12h"
+
+	// maxJobDuration is the default maximum time that each job can run for, as
+	// a duration (eg. "10m").  Jobs which run for longer are cancelled and
+	// retried.  If unset, jobs can run indefinitely.
+	maxJobDuration?: string
+}
+
+// SqliteQueue uses a SQLite database file as the backing queue.  This is a
+// durable queue for small installs and CI, which can share a single file with
+// the SQLite state and data stores.
+#SqliteQueue: {
+	backend: "sqlite"
+	path:    string | *"inngest.db"
+
+	// numWorkers specifies how many concurrent queue items - and therefore
+	// function steps - can be handled in parallel by each process.
+	numWorkers: >=1 | *100
+
+	// pollTick is the interval between each scan for available jobs, as a
+	// duration (eg. "100ms").
+	pollTick: string | *"100ms"
+
+	// idempotencyTTL is how long a dequeued job ID is remembered, preventing
+	// jobs with the same ID from being enqueued again within this period.
+	idempotencyTTL: string | *"12h"
+
+	// maxJobDuration is the default maximum time that each job can run for, as
+	// a duration (eg. "10m").  Jobs which run for longer are cancelled and
+	// retried.  If unset, jobs can run indefinitely.
+	maxJobDuration?: string
 }
 
 // # State
 //
 // State stores distributed state when running functions.  You can choose one of
 // StateServices as the backend to host state.
-#StateService: #InmemState | #RedisState
+#StateService: #InmemState | #RedisState | #PostgresState | #SqliteState
 
 // InmemState stores state in memory, local to each process.  This should only
 // be used for development or testing, but never for production.
@@ -188,10 +268,27 @@ package config
 	keyPrefix: string | *"inngest:state"
 }
 
+// PostgresState uses PostgreSQL as the backend state store.  The migrations
+// within pkg/execution/state/postgres_state/migrations must be run before use.
+#PostgresState: {
+	backend: "postgres"
+	URI:     string | *"postgres://localhost:5432/postgres?sslmode=disable"
+
+	// maxOpenConns is the maximum number of open connections to the database.
+	maxOpenConns: >=1 | *20
+}
+
+// SqliteState uses a SQLite database file as the backend state store.  The
+// file and its tables are created if they don't exist.
+#SqliteState: {
+	backend: "sqlite"
+	path:    string | *"inngest.db"
+}
+
 // # DataStore
 //
 // DataStore stores the persisted system data including Functions and Actions versions
-#DataStoreService: #InmemDataStore | #PostgresDataStore
+#DataStoreService: #InmemDataStore | #PostgresDataStore | #SqliteDataStore
 
 // InmemDataStore stores data in memory, local to each process. This should only
 // be used for development or testing, never for production.
@@ -205,6 +302,13 @@ package config
 	URI:     string | *"postgres://localhost:5432/postgres?sslmode=disable"
 }
 
+// SqliteDataStore uses a SQLite database file.  The file and its tables are
+// created if they don't exist.
+#SqliteDataStore: {
+	backend: "sqlite"
+	path:    string | *"inngest.db"
+}
+
 // Drivers handle execution of each step within a function.
 #Driver: #DockerDriver | #MockDriver | #HTTPDriver
 
@@ -13,6 +13,9 @@ package v1
 	// idempotency allows the specification of an idempotency key using event data.
 	// If specified, this overrides the throttle object.
 	idempotency?: string
+	// idempotencyPeriod is the period for which idempotency keys are held, eg.
+	// "1h".  This defaults to 24 hours.
+	idempotencyPeriod?: string
 	// throttle allows you to throttle workflows, only running them a given number
 	// of times (count) per period.  This can optionally include a throttle key,
 	// which is used to  further constrain throttling similar to idempotency.
@@ -21,6 +24,29 @@ package v1
 		count:  uint & >=1 | *1
 		period: string
 	}
+	// concurrency limits the number of steps for the function which can run at
+	// the same time.  This can optionally include a key expression, such as
+	// "event.data.account_id", which limits concurrency for each evaluated key.
+	concurrency?: {
+		limit: uint & >=1
+		key?:  string
+	}
+	// debounce delays runs until no matching events have been received for the
+	// given period (eg. "30s"), running once with the last event.  This can
+	// optionally include a key expression, such as "event.user.id", which
+	// debounces runs for each evaluated key.
+	debounce?: {
+		period: string
+		key?:   string
+	}
+
+	// timeouts ends runs which take too long.  run limits each run's total
+	// duration (eg. "2h"), and start limits the time before the run's first
+	// step starts (eg. "10m").  Runs exceeding either timeout are cancelled.
+	timeouts?: {
+		run?:   string
+		start?: string
+	}
 
 	cancel?: [...#Cancel]
 }
@@ -42,6 +68,15 @@ package v1
 	// with events which are not yet stored within Inngest.  We allow you to store
 	// a type for the event directly here.
 	definition?: #EventDefinition
+
+	// Batch invokes the function with a batch of up to maxSize matching events,
+	// starting a run once the batch is full or timeout (eg. "5s") elapses.  An
+	// optional key expression accumulates separate batches per evaluated key.
+	batch?: {
+		maxSize: int & >=1
+		timeout: string
+		key?:    string
+	}
 }
 
 #CronTrigger: {
@@ -93,14 +128,42 @@ package v1
 
 	retries?: {
 		attempts?: int & >=0 & <=20
+		// backoff configures the delay between each retry.  This defaults to an
+		// exponential backoff starting at 10 seconds.
+		backoff?: #Backoff
 	}
+
+	// timeout is the maximum duration of each attempt of the step, eg. "30s".
+	// Steps which time out are retried.
+	timeout?: string
+}
+
+#Backoff: {
+	// exponential doubles the delay for each retry, starting at base.
+	type:    "exponential"
+	base?:   string
+	max?:    string
+	jitter?: number & >=0
+} | {
+	// fixed uses the same interval for every retry.
+	type:     "fixed"
+	interval: string
+	max?:     string
+	jitter?:  number & >=0
+} | {
+	// schedule uses an explicit delay for each retry, repeating the last delay
+	// once the schedule is exhausted.
+	type: "schedule"
+	schedule: [string, ...string]
+	max?:    string
+	jitter?: number & >=0
 }
 
 #After: {
-	step: string | "$trigger"
-	// TODO: support Promise.all() like support in which we wait after all steps
-	// specified in an array are finished before running this once.
-	// steps?: [...string]
+	step?: string | "$trigger"
+	// steps runs this step once after all of the specified steps have finished,
+	// or have been skipped.  This cannot be used with step, if, or async.
+	steps?: [...string]
 	if?: string
 	// wait allows you to delay a step from running for a set amount of time, eg.
 	// to delay a step from running you can set wait to "10m".  This will enqueue
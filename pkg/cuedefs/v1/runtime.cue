@@ -10,4 +10,9 @@ package v1
 #RuntimeHTTP: {
 	type: "http"
 	url:  string
+	// connectTimeout is the maximum duration for connecting to the url.
+	connectTimeout?: string
+	// responseTimeout is the maximum duration to wait for a response after
+	// sending each request.
+	responseTimeout?: string
 }
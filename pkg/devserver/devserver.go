@@ -92,7 +92,11 @@ func start(ctx context.Context, opts StartOpts, loader *inmemorydatastore.FSLoad
 		executor.WithEnvReader(envreader),
 		executor.WithState(sm),
 	)
-	coreapi := coreapi.NewService(opts.Config, coreapi.WithRunner(runner))
+	coreapi := coreapi.NewService(
+		opts.Config,
+		coreapi.WithRunner(runner),
+		coreapi.WithExecutionLoader(loader),
+	)
 
 	// Add notifications to the state manager so that we can store new function runs
 	// in the core API service.
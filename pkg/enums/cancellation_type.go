@@ -10,4 +10,7 @@ const (
 
 	CancellationTypeEvent
 	CancellationTypeManual
+	// CancellationTypeTimeout represents a run cancelled after exceeding one of
+	// its function's timeouts.
+	CancellationTypeTimeout
 )
@@ -8,9 +8,9 @@ import (
 	"fmt"
 )
 
-const _CancellationTypeName = "NoneEventManual"
+const _CancellationTypeName = "NoneEventManualTimeout"
 
-var _CancellationTypeIndex = [...]uint8{0, 4, 9, 15}
+var _CancellationTypeIndex = [...]uint8{0, 4, 9, 15, 22}
 
 func (i CancellationType) String() string {
 	if i < 0 || i >= CancellationType(len(_CancellationTypeIndex)-1) {
@@ -19,12 +19,13 @@ func (i CancellationType) String() string {
 	return _CancellationTypeName[_CancellationTypeIndex[i]:_CancellationTypeIndex[i+1]]
 }
 
-var _CancellationTypeValues = []CancellationType{0, 1, 2}
+var _CancellationTypeValues = []CancellationType{0, 1, 2, 3}
 
 var _CancellationTypeNameToValueMap = map[string]CancellationType{
-	_CancellationTypeName[0:4]:  0,
-	_CancellationTypeName[4:9]:  1,
-	_CancellationTypeName[9:15]: 2,
+	_CancellationTypeName[0:4]:   0,
+	_CancellationTypeName[4:9]:   1,
+	_CancellationTypeName[9:15]:  2,
+	_CancellationTypeName[15:22]: 3,
 }
 
 // CancellationTypeFromString retrieves an enum value from the enum constants string name.
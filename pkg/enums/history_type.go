@@ -20,4 +20,10 @@ const (
 	HistoryTypeStepFailed
 
 	HistoryTypeStepWaiting
+
+	HistoryTypeFunctionSkipped
+
+	// HistoryTypeStepTimedOut represents a step which was cancelled after
+	// exceeding its max duration.  The step is retried.
+	HistoryTypeStepTimedOut
 )
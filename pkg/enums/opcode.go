@@ -10,4 +10,12 @@ const (
 	OpcodeStep
 	OpcodeSleep
 	OpcodeWaitForEvent
+	// OpcodeStepPlanned represents a step which the SDK found but has not yet
+	// run, allowing many steps to be planned and run in parallel.
+	OpcodeStepPlanned
+	// OpcodeInvokeFunction starts a run of another function, pausing until
+	// the invoked run finishes.
+	OpcodeInvokeFunction
+	// OpcodeSendEvent sends one or more events from within a function.
+	OpcodeSendEvent
 )
@@ -8,9 +8,9 @@ import (
 	"fmt"
 )
 
-const _OpcodeName = "NoneStepSleepWaitForEvent"
+const _OpcodeName = "NoneStepSleepWaitForEventStepPlannedInvokeFunctionSendEvent"
 
-var _OpcodeIndex = [...]uint8{0, 4, 8, 13, 25}
+var _OpcodeIndex = [...]uint8{0, 4, 8, 13, 25, 36, 50, 59}
 
 func (i Opcode) String() string {
 	if i < 0 || i >= Opcode(len(_OpcodeIndex)-1) {
@@ -19,13 +19,16 @@ func (i Opcode) String() string {
 	return _OpcodeName[_OpcodeIndex[i]:_OpcodeIndex[i+1]]
 }
 
-var _OpcodeValues = []Opcode{0, 1, 2, 3}
+var _OpcodeValues = []Opcode{0, 1, 2, 3, 4, 5, 6}
 
 var _OpcodeNameToValueMap = map[string]Opcode{
 	_OpcodeName[0:4]:   0,
 	_OpcodeName[4:8]:   1,
 	_OpcodeName[8:13]:  2,
 	_OpcodeName[13:25]: 3,
+	_OpcodeName[25:36]: 4,
+	_OpcodeName[36:50]: 5,
+	_OpcodeName[50:59]: 6,
 }
 
 // OpcodeFromString retrieves an enum value from the enum constants string name.
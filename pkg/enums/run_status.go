@@ -19,6 +19,9 @@ const (
 	// RunStatusCancelled indicates that the function has been cancelled prior
 	// to any errors
 	RunStatusCancelled
+	// RunStatusSkipped indicates that the function was never started, eg. as
+	// a run with the same idempotency key already exists.
+	RunStatusSkipped
 )
 
 func (r RunStatus) MarshalBinary() ([]byte, error) {
@@ -8,9 +8,9 @@ import (
 	"fmt"
 )
 
-const _RunStatusName = "RunningCompletedFailedCancelled"
+const _RunStatusName = "RunningCompletedFailedCancelledSkipped"
 
-var _RunStatusIndex = [...]uint8{0, 7, 16, 22, 31}
+var _RunStatusIndex = [...]uint8{0, 7, 16, 22, 31, 38}
 
 func (i RunStatus) String() string {
 	if i < 0 || i >= RunStatus(len(_RunStatusIndex)-1) {
@@ -19,13 +19,14 @@ func (i RunStatus) String() string {
 	return _RunStatusName[_RunStatusIndex[i]:_RunStatusIndex[i+1]]
 }
 
-var _RunStatusValues = []RunStatus{0, 1, 2, 3}
+var _RunStatusValues = []RunStatus{0, 1, 2, 3, 4}
 
 var _RunStatusNameToValueMap = map[string]RunStatus{
 	_RunStatusName[0:7]:   0,
 	_RunStatusName[7:16]:  1,
 	_RunStatusName[16:22]: 2,
 	_RunStatusName[22:31]: 3,
+	_RunStatusName[31:38]: 4,
 }
 
 // RunStatusFromString retrieves an enum value from the enum constants string name.
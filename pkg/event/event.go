@@ -5,6 +5,12 @@ import (
 	"sync"
 )
 
+const (
+	// EventReceivedName is the name of the pub/sub message which publishes
+	// events to the event stream.
+	EventReceivedName = "event/event.received"
+)
+
 type Manager struct {
 	events map[string]Event
 	l      *sync.RWMutex
@@ -23,21 +23,44 @@ type EnvManager interface {
 	SetEnvReader(r env.EnvReader)
 }
 
+type generatorStepKey struct{}
+
+// WithGeneratorStep returns a context specifying the ID of the planned generator
+// step to run when invoking an SDK-based function.
+func WithGeneratorStep(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, generatorStepKey{}, id)
+}
+
+// GeneratorStep returns the ID of the planned generator step to run, or an empty
+// string if the SDK-based function should run until it plans its next steps.
+func GeneratorStep(ctx context.Context) string {
+	id, _ := ctx.Value(generatorStepKey{}).(string)
+	return id
+}
+
 // MarshalV1 marshals state as an input to driver runtimes.
 func MarshalV1(ctx context.Context, s state.State, step inngest.Step) ([]byte, error) {
+	runCtx := map[string]interface{}{
+		// fn_id is used within entrypoints to SDK-based functions in
+		// order to specify the ID of the function to run via RPC.
+		"fn_id": s.Workflow().ID,
+		// step_id is used within entrypoints to SDK-based functions in
+		// order to specify the step of the function to run via RPC.
+		"step_id": step.ID,
+		// XXX: Pass in opentracing context within ctx.
+		"run_id": s.RunID(),
+	}
+	if id := GeneratorStep(ctx); id != "" {
+		// gen_step_id specifies the planned step that SDK-based functions
+		// must run, allowing planned steps to run in parallel.
+		runCtx["gen_step_id"] = id
+	}
+
 	data := map[string]interface{}{
-		"event": s.Event(),
-		"steps": s.Actions(),
-		"ctx": map[string]interface{}{
-			// fn_id is used within entrypoints to SDK-based functions in
-			// order to specify the ID of the function to run via RPC.
-			"fn_id": s.Workflow().ID,
-			// step_id is used within entrypoints to SDK-based functions in
-			// order to specify the step of the function to run via RPC.
-			"step_id": step.ID,
-			// XXX: Pass in opentracing context within ctx.
-			"run_id": s.RunID(),
-		},
+		"event":  s.Event(),
+		"events": s.Events(),
+		"steps":  s.Actions(),
+		"ctx":    runCtx,
 	}
 	return json.Marshal(data)
 }
@@ -1,7 +1,6 @@
 package httpdriver
 
 import (
-	"net/http"
 	"time"
 
 	"github.com/inngest/inngest/pkg/execution/driver"
@@ -17,7 +16,9 @@ func init() {
 // services via config.cue
 type Config struct {
 	SigningKey string
-	Timeout    int
+	// Timeout is the maximum duration of each step, in seconds, for steps which
+	// don't specify their own timeout.
+	Timeout int
 }
 
 // RuntimeName returns the runtime field that should invoke this driver.
@@ -27,10 +28,5 @@ func (Config) RuntimeName() string { return "http" }
 func (Config) DriverName() string { return "http" }
 
 func (c Config) NewDriver() (driver.Driver, error) {
-	return executor{
-		client: &http.Client{
-			Timeout: time.Duration(c.Timeout) * time.Second,
-		},
-		signingKey: []byte(c.SigningKey),
-	}, nil
+	return newExecutor(time.Duration(c.Timeout)*time.Second, []byte(c.SigningKey)), nil
 }
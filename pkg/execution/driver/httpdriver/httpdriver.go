@@ -7,9 +7,12 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/inngest/inngest/inngest"
@@ -18,12 +21,14 @@ import (
 	"github.com/inngest/inngest/pkg/execution/state"
 )
 
+const (
+	// DefaultTimeout is the maximum duration of each step which doesn't specify
+	// its own timeout.
+	DefaultTimeout = 15 * time.Minute
+)
+
 var (
-	DefaultExecutor = executor{
-		client: &http.Client{
-			Timeout: 15 * time.Minute,
-		},
-	}
+	DefaultExecutor = newExecutor(DefaultTimeout, nil)
 )
 
 func Execute(ctx context.Context, s state.State, action inngest.ActionVersion, step inngest.Step) (*state.DriverResponse, error) {
@@ -31,8 +36,48 @@ func Execute(ctx context.Context, s state.State, action inngest.ActionVersion, s
 }
 
 type executor struct {
-	client     *http.Client
 	signingKey []byte
+	// timeout is the maximum duration of each step which doesn't specify its
+	// own timeout.
+	timeout time.Duration
+	// clients stores a client for each pair of connect and response timeouts,
+	// allowing connections to be reused across calls.
+	clients *sync.Map
+}
+
+func newExecutor(timeout time.Duration, signingKey []byte) executor {
+	return executor{
+		signingKey: signingKey,
+		timeout:    timeout,
+		clients:    &sync.Map{},
+	}
+}
+
+// clientTimeouts keys the clients used for each runtime's timeouts.
+type clientTimeouts struct {
+	connect  time.Duration
+	response time.Duration
+}
+
+// client returns the client to use for the given runtime's connect and response
+// timeouts.
+func (e executor) client(connect, response time.Duration) *http.Client {
+	key := clientTimeouts{connect: connect, response: response}
+	if c, ok := e.clients.Load(key); ok {
+		return c.(*http.Client)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if connect > 0 {
+		transport.DialContext = (&net.Dialer{
+			Timeout:   connect,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+	}
+	transport.ResponseHeaderTimeout = response
+
+	c, _ := e.clients.LoadOrStore(key, &http.Client{Transport: transport})
+	return c.(*http.Client)
 }
 
 // RuntimeType fulfiils the inngest.Runtime interface.
@@ -66,12 +111,30 @@ func (e executor) Execute(ctx context.Context, s state.State, action inngest.Act
 		return nil, fmt.Errorf("Unable to use HTTP executor for non-HTTP runtime")
 	}
 
+	connect, response, err := rt.Timeouts()
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := step.TimeoutDuration()
+	if err != nil {
+		return nil, err
+	}
+	if timeout == 0 {
+		timeout = e.timeout
+	}
+
 	input, err := driver.MarshalV1(ctx, s, step)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, rt.URL, bytes.NewBuffer(input))
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rt.URL, bytes.NewBuffer(input))
 	if err != nil {
 		return nil, err
 	}
@@ -81,27 +144,36 @@ func (e executor) Execute(ctx context.Context, s state.State, action inngest.Act
 		req.Header.Add("X-Inngest-Signature", Sign(ctx, e.signingKey, input))
 	}
 
-	resp, err := e.client.Do(req)
+	resp, err := e.client(connect, response).Do(req)
 	if err != nil {
+		if isTimeout(err) {
+			return timedOut(action, err), nil
+		}
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	byt, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
 	if err != nil {
+		if isTimeout(err) {
+			return timedOut(action, err), nil
+		}
 		return nil, err
 	}
 
 	if resp.StatusCode == 206 {
 		// This is a generator-based function returning opcodes.
-		gen := &state.GeneratorOpcode{}
-		if err := json.Unmarshal(byt, gen); err != nil {
+		gen, err := parseGenerator(byt)
+		if err != nil {
 			// When we return a 206, we always expect that this is
 			// a generator function.  Users SHOULD NOT return a 206
 			// in any other circumstance.
 			return nil, fmt.Errorf("error reading generator opcode response: %w", err)
 		}
-		if gen.Op == enums.OpcodeNone {
-			return nil, fmt.Errorf("invalid opcode returned in response")
+		for _, op := range gen {
+			if op == nil || op.Op == enums.OpcodeNone {
+				return nil, fmt.Errorf("invalid opcode returned in response")
+			}
 		}
 
 		return &state.DriverResponse{
@@ -135,3 +207,42 @@ func (e executor) Execute(ctx context.Context, s state.State, action inngest.Act
 		ActionVersion: action.Version,
 	}, nil
 }
+
+// parseGenerator parses the opcodes within a generator response.  SDKs return
+// either a single opcode, or an array of opcodes when planning many steps at once.
+func parseGenerator(byt []byte) ([]*state.GeneratorOpcode, error) {
+	gen := []*state.GeneratorOpcode{}
+	if trimmed := bytes.TrimSpace(byt); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &gen); err != nil {
+			return nil, err
+		}
+		if len(gen) == 0 {
+			return nil, fmt.Errorf("no opcodes returned in response")
+		}
+		return gen, nil
+	}
+
+	op := &state.GeneratorOpcode{}
+	if err := json.Unmarshal(byt, op); err != nil {
+		return nil, err
+	}
+	return append(gen, op), nil
+}
+
+// isTimeout returns whether the error is caused by exceeding the step's timeout,
+// or the runtime's connect or response timeouts.
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr) && nerr.Timeout()
+}
+
+// timedOut returns a retryable response for a call which timed out.
+func timedOut(action inngest.ActionVersion, err error) *state.DriverResponse {
+	return &state.DriverResponse{
+		Err:           fmt.Errorf("%w: %s", state.ErrStepTimedOut, err),
+		ActionVersion: action.Version,
+	}
+}
@@ -0,0 +1,141 @@
+package httpdriver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/driver"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteTimeouts(t *testing.T) {
+	ctx := context.Background()
+
+	// The server waits before responding.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer srv.Close()
+
+	s := inmemory.NewStateInstance(inngest.Workflow{}, state.Identifier{}, state.Metadata{}, nil, nil, nil, nil)
+	e := newExecutor(DefaultTimeout, nil)
+
+	short, long := "50ms", "1s"
+	tests := []struct {
+		name     string
+		runtime  inngest.RuntimeHTTP
+		step     inngest.Step
+		timedOut bool
+	}{
+		{
+			name:    "It completes steps within their timeouts",
+			runtime: inngest.RuntimeHTTP{URL: srv.URL, ResponseTimeout: &long},
+			step:    inngest.Step{ID: "step", Timeout: &long},
+		},
+		{
+			name:     "It times out steps exceeding the step timeout",
+			runtime:  inngest.RuntimeHTTP{URL: srv.URL},
+			step:     inngest.Step{ID: "step", Timeout: &short},
+			timedOut: true,
+		},
+		{
+			name:     "It times out steps exceeding the response timeout",
+			runtime:  inngest.RuntimeHTTP{URL: srv.URL, ResponseTimeout: &short},
+			step:     inngest.Step{ID: "step"},
+			timedOut: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			action := inngest.ActionVersion{
+				Runtime: inngest.RuntimeWrapper{Runtime: test.runtime},
+			}
+			resp, err := e.Execute(ctx, s, action, test.step)
+			require.NoError(t, err)
+			require.Equal(t, test.timedOut, resp.TimedOut())
+			if !test.timedOut {
+				require.NoError(t, resp.Err)
+				require.Equal(t, 200, resp.Output.(map[string]any)["status"])
+				return
+			}
+			// Timeouts are retried.
+			require.True(t, resp.Retryable())
+		})
+	}
+}
+
+func TestExecuteGenerator(t *testing.T) {
+	var (
+		body    string
+		genStep any
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		input := map[string]map[string]any{}
+		byt, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(byt, &input)
+		genStep = input["ctx"]["gen_step_id"]
+
+		w.WriteHeader(206)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	s := inmemory.NewStateInstance(inngest.Workflow{}, state.Identifier{}, state.Metadata{}, nil, nil, nil, nil)
+	e := newExecutor(DefaultTimeout, nil)
+	action := inngest.ActionVersion{
+		Runtime: inngest.RuntimeWrapper{Runtime: inngest.RuntimeHTTP{URL: srv.URL}},
+	}
+
+	t.Run("It accepts a single opcode", func(t *testing.T) {
+		body = `{"op":"Step","id":"a","data":{"ok":true}}`
+		resp, err := e.Execute(context.Background(), s, action, inngest.Step{ID: "step"})
+		require.NoError(t, err)
+		require.Len(t, resp.Generator, 1)
+		require.Equal(t, enums.OpcodeStep, resp.Generator[0].Op)
+		require.Equal(t, "a", resp.Generator[0].ID)
+		require.Nil(t, genStep)
+	})
+
+	t.Run("It accepts many planned steps", func(t *testing.T) {
+		body = ` [{"op":"StepPlanned","id":"a"},{"op":"StepPlanned","id":"b"}]`
+		resp, err := e.Execute(context.Background(), s, action, inngest.Step{ID: "step"})
+		require.NoError(t, err)
+		require.Len(t, resp.Generator, 2)
+		require.Equal(t, enums.OpcodeStepPlanned, resp.Generator[0].Op)
+		require.Equal(t, "a", resp.Generator[0].ID)
+		require.Equal(t, "b", resp.Generator[1].ID)
+	})
+
+	t.Run("It sends the planned step to run", func(t *testing.T) {
+		body = `[{"op":"Step","id":"b","data":{"ok":true}}]`
+		ctx := driver.WithGeneratorStep(context.Background(), "b")
+		resp, err := e.Execute(ctx, s, action, inngest.Step{ID: "step"})
+		require.NoError(t, err)
+		require.Len(t, resp.Generator, 1)
+		require.Equal(t, "b", genStep)
+	})
+
+	t.Run("It rejects invalid opcodes", func(t *testing.T) {
+		for _, invalid := range []string{`[]`, `[{"op":"None"}]`, `{"op":"None"}`} {
+			body = invalid
+			_, err := e.Execute(context.Background(), s, action, inngest.Step{ID: "step"})
+			require.Error(t, err, invalid)
+		}
+	})
+}
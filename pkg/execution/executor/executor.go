@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/inngest/inngest/inngest"
@@ -14,7 +15,9 @@ import (
 	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/state"
 	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/metrics"
 	"github.com/rs/zerolog"
+	"github.com/uber-go/tally/v4"
 )
 
 var (
@@ -79,6 +82,7 @@ type Executor interface {
 func NewExecutor(opts ...ExecutorOpt) (Executor, error) {
 	m := &executor{
 		runtimeDrivers: map[string]driver.Driver{},
+		metrics:        metrics.Scope().SubScope("executor"),
 	}
 
 	for _, o := range opts {
@@ -132,6 +136,15 @@ func WithConfig(c config.Execution) ExecutorOpt {
 	}
 }
 
+// WithMetricsScope sets the scope used to report executor metrics, defaulting
+// to the "executor" sub-scope of the global metrics scope.
+func WithMetricsScope(scope tally.Scope) ExecutorOpt {
+	return func(e Executor) error {
+		e.(*executor).metrics = scope
+		return nil
+	}
+}
+
 // WithRuntimeDrivers specifies the drivers available to use when executing steps
 // of a function.
 //
@@ -159,6 +172,7 @@ type executor struct {
 	sm             state.Manager
 	al             coredata.ExecutionActionLoader
 	runtimeDrivers map[string]driver.Driver
+	metrics        tally.Scope
 }
 
 // Execute loads a workflow and the current run state, then executes the
@@ -194,8 +208,13 @@ func (e *executor) Execute(ctx context.Context, id state.Identifier, from string
 	// each action to properly evaluate the next set of edges.
 	//
 	// To fix this particular consistency issue, always check to see
-	// if there's output stored for this action ID.
-	if resp, _ := s.ActionID(from); resp != nil {
+	// if there's output stored for this action ID.  Planned generator
+	// steps store output using the generator step's ID.
+	outputID := from
+	if gen := driver.GeneratorStep(ctx); gen != "" {
+		outputID = gen
+	}
+	if resp, _ := s.ActionID(outputID); resp != nil {
 		if log != nil {
 			log.Warn().Msg("step already executed")
 		}
@@ -342,7 +361,16 @@ func (e *executor) executeAction(ctx context.Context, id state.Identifier, actio
 		return nil, fmt.Errorf("error saving started state: %w", err)
 	}
 
-	response, err := d.Execute(ctx, s, *definition, *action)
+	start := time.Now()
+	response, err := e.execute(ctx, d, s, *definition, *action)
+	defer func() {
+		e.recordStep(response, err, time.Since(start))
+	}()
+	if response != nil && response.TimedOut() && ctx.Err() != nil {
+		// The job's context is done, so the response must be saved using a
+		// new context.
+		ctx = logger.With(context.Background(), *logger.From(ctx))
+	}
 	if err != nil || response == nil {
 		return nil, fmt.Errorf("error executing action: %w", err)
 	}
@@ -351,23 +379,6 @@ func (e *executor) executeAction(ctx context.Context, id state.Identifier, actio
 	// set this.
 	response.Step = *action
 
-	// NOTE: We must ensure that the Step ID is overwritten for Generator steps.  Generator
-	// steps are executed many times until they stop yielding;  each execution returns a
-	// new step ID and data that must be stored independently of the parent generator ID.
-	//
-	// By updating the step ID, we ensure that the data will be saved to the generator's ID.
-	if response.Generator != nil {
-		response.Step.ID = response.Generator.ID
-		response.Step.Name = response.Generator.Name
-		// Unmarshal the generator data into the step.
-		if response.Generator.Data != nil {
-			err = json.Unmarshal(response.Generator.Data, &response.Output)
-			if err != nil {
-				response.Err = fmt.Errorf("error unmarshalling generator step data as json: %w", err)
-			}
-		}
-	}
-
 	if response.ActionVersion == nil {
 		// Set the ActionVersion automatically from the executor, where
 		// provided from the definition.
@@ -386,24 +397,61 @@ func (e *executor) executeAction(ctx context.Context, id state.Identifier, actio
 		return response, nil
 	}
 
-	if response.Err != nil && !queue.ShouldRetry(err, attempt, action.RetryCount()) {
-		// We need to detect whether this error is 'final' here, depending on whether
-		// we've hit the retry count or this error is deemed non-retryable.
-		//
-		// When this error is final, we need to store the error and update the step
-		// as finalized within the state store.
-		response.SetFinal()
+	// NOTE: We must ensure that the Step ID is overwritten for Generator steps.  Generator
+	// steps are executed many times until they stop yielding;  each execution returns a
+	// new step ID and data that must be stored independently of the parent generator ID.
+	//
+	// By updating the step ID, we ensure that the data will be saved to the generator's ID.
+	// Generators may return many opcodes at once, in which case each opcode is saved
+	// separately.  Planned steps haven't run yet, so they have no data to save, sent
+	// events are saved once they're published, and invoked functions are saved once
+	// the invoked run finishes.
+	responses := []*state.DriverResponse{response}
+	if len(response.Generator) > 0 {
+		responses = nil
+		for _, gen := range response.Generator {
+			if gen.Op == enums.OpcodeStepPlanned || gen.Op == enums.OpcodeSendEvent || gen.Op == enums.OpcodeInvokeFunction {
+				continue
+			}
+			r := response
+			if len(response.Generator) > 1 {
+				copied := *response
+				copied.Output = nil
+				r = &copied
+			}
+			r.Step.ID = gen.ID
+			r.Step.Name = gen.Name
+			// Unmarshal the generator data into the step.
+			if gen.Data != nil {
+				err = json.Unmarshal(gen.Data, &r.Output)
+				if err != nil {
+					r.Err = fmt.Errorf("error unmarshalling generator step data as json: %w", err)
+				}
+			}
+			responses = append(responses, r)
+		}
 	}
 
-	if l != nil {
-		logger.From(ctx).Trace().Msg("saving response to state")
-	}
+	for _, r := range responses {
+		if r.Err != nil && !queue.ShouldRetry(err, attempt, action.RetryCount()) {
+			// We need to detect whether this error is 'final' here, depending on whether
+			// we've hit the retry count or this error is deemed non-retryable.
+			//
+			// When this error is final, we need to store the error and update the step
+			// as finalized within the state store.
+			r.SetFinal()
+		}
 
-	if _, serr := e.sm.SaveResponse(ctx, id, *response, attempt); serr != nil {
 		if l != nil {
-			logger.From(ctx).Error().Err(err).Msg("unable to save state")
+			logger.From(ctx).Trace().Msg("saving response to state")
+		}
+
+		if _, serr := e.sm.SaveResponse(ctx, id, *r, attempt); serr != nil {
+			if l != nil {
+				logger.From(ctx).Error().Err(err).Msg("unable to save state")
+			}
+			err = multierror.Append(err, serr)
 		}
-		err = multierror.Append(err, serr)
 	}
 
 	return response, err
@@ -429,3 +477,67 @@ func (e execError) Retryable() bool {
 func newFinalError(err error) error {
 	return execError{err: err, final: true}
 }
+
+// execute runs the step using the given driver.  If the step exceeds its max
+// duration, the queue cancels the context and this returns a timed out response
+// so that the step is retried and recorded separately from step errors.  This
+// doesn't wait for drivers which ignore the cancelled context.
+func (e *executor) execute(ctx context.Context, d driver.Driver, s state.State, av inngest.ActionVersion, step inngest.Step) (*state.DriverResponse, error) {
+	type result struct {
+		resp *state.DriverResponse
+		err  error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		resp, err := d.Execute(ctx, s, av, step)
+		ch <- result{resp: resp, err: err}
+	}()
+
+	var r result
+	select {
+	case r = <-ch:
+	case <-ctx.Done():
+		select {
+		case r = <-ch:
+			// The driver returned as the context finished.
+		default:
+			if ctx.Err() != context.DeadlineExceeded {
+				// Drivers handle cancellation themselves.
+				r = <-ch
+				break
+			}
+			return timedOut("the step was cancelled"), nil
+		}
+	}
+
+	if r.err != nil && ctx.Err() == context.DeadlineExceeded {
+		// The driver failed as the step was cancelled.  Successful responses
+		// are kept, as the step finished before it was cancelled.
+		return timedOut(r.err.Error()), nil
+	}
+	return r.resp, r.err
+}
+
+func timedOut(msg string) *state.DriverResponse {
+	return &state.DriverResponse{Err: fmt.Errorf("%w: %s", state.ErrStepTimedOut, msg)}
+}
+
+// recordStep reports the duration and outcome of a single step execution.
+func (e *executor) recordStep(resp *state.DriverResponse, err error, dur time.Duration) {
+	status := "completed"
+	switch {
+	case err != nil || resp == nil:
+		status = "error"
+	case resp.TimedOut():
+		status = "timed_out"
+	case resp.Scheduled:
+		status = "scheduled"
+	case resp.Err != nil:
+		status = "errored"
+	}
+
+	scope := e.metrics.Tagged(map[string]string{"status": status})
+	scope.Counter("steps_executed").Inc(1)
+	scope.Timer("step_duration").Record(dur)
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/inngest/inngest/inngest"
@@ -191,6 +192,134 @@ func TestExecute_state(t *testing.T) {
 	assert.Equal(t, 1, len(s.Errors()))
 }
 
+func TestExecute_timeout(t *testing.T) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+
+	al := inmemorydatastore.NewInMemoryActionLoader()
+	al.Add(inngest.ActionVersion{
+		DSN: "test",
+		Runtime: inngest.RuntimeWrapper{
+			Runtime: &mockdriver.Mock{},
+		},
+	})
+
+	w := inngest.Workflow{
+		UUID:  uuid.New(),
+		Steps: []inngest.Step{{DSN: "test", ID: "1"}},
+		Edges: []inngest.Edge{{Outgoing: inngest.TriggerName, Incoming: "1"}},
+	}
+	s, err := sm.New(ctx, state.Input{
+		Workflow:   w,
+		Identifier: state.Identifier{RunID: ulid.MustNew(ulid.Now(), rand.Reader)},
+		EventData:  map[string]interface{}{},
+	})
+	require.NoError(t, err)
+
+	driver := &mockdriver.Mock{
+		Errors: map[string]error{"1": context.DeadlineExceeded},
+	}
+	exec, err := NewExecutor(
+		WithStateManager(sm),
+		WithActionLoader(al),
+		WithRuntimeDrivers(driver),
+	)
+	require.NoError(t, err)
+
+	// The queue cancels the step's context once the step exceeds its max duration.
+	jobCtx, cancel := context.WithTimeout(ctx, 0)
+	defer cancel()
+
+	resp, err := exec.Execute(jobCtx, s.Identifier(), "1", 0)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	require.True(t, resp.TimedOut())
+	require.True(t, resp.Retryable())
+
+	s, err = sm.Load(ctx, s.RunID())
+	require.NoError(t, err)
+	require.ErrorIs(t, s.Errors()["1"], state.ErrStepTimedOut)
+
+	history, err := sm.History(ctx, s.RunID())
+	require.NoError(t, err)
+	require.Equal(t, enums.HistoryTypeStepTimedOut, history[len(history)-1].Type)
+}
+
+func TestExecute_timeoutIgnoringContext(t *testing.T) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+
+	al := inmemorydatastore.NewInMemoryActionLoader()
+	al.Add(inngest.ActionVersion{
+		DSN: "test",
+		Runtime: inngest.RuntimeWrapper{
+			Runtime: &mockdriver.Mock{},
+		},
+	})
+
+	w := inngest.Workflow{
+		UUID:  uuid.New(),
+		Steps: []inngest.Step{{DSN: "test", ID: "1"}, {DSN: "test", ID: "2"}},
+		Edges: []inngest.Edge{
+			{Outgoing: inngest.TriggerName, Incoming: "1"},
+			{Outgoing: inngest.TriggerName, Incoming: "2"},
+		},
+	}
+	s, err := sm.New(ctx, state.Input{
+		Workflow:   w,
+		Identifier: state.Identifier{RunID: ulid.MustNew(ulid.Now(), rand.Reader)},
+		EventData:  map[string]interface{}{},
+	})
+	require.NoError(t, err)
+
+	driver := &mockdriver.Mock{
+		DynamicResponses: func(ctx context.Context, _ state.State, _ inngest.ActionVersion, step inngest.Step) map[string]state.DriverResponse {
+			if step.ID == "1" {
+				// This step ignores the cancelled context.
+				time.Sleep(5 * time.Second)
+			}
+			return map[string]state.DriverResponse{
+				step.ID: {Output: map[string]interface{}{"ok": true}},
+			}
+		},
+	}
+	exec, err := NewExecutor(
+		WithStateManager(sm),
+		WithActionLoader(al),
+		WithRuntimeDrivers(driver),
+	)
+	require.NoError(t, err)
+
+	t.Run("It keeps responses returned before the deadline", func(t *testing.T) {
+		jobCtx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+
+		resp, err := exec.Execute(jobCtx, s.Identifier(), "2", 0)
+		require.NoError(t, err)
+		require.False(t, resp.TimedOut())
+
+		run, err := sm.Load(ctx, s.RunID())
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{"ok": true}, run.Actions()["2"])
+	})
+
+	t.Run("It records the timeout without waiting for the driver", func(t *testing.T) {
+		jobCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		resp, err := exec.Execute(jobCtx, s.Identifier(), "1", 0)
+		require.Error(t, err)
+		require.Less(t, time.Since(start), time.Second)
+		require.NotNil(t, resp)
+		require.True(t, resp.TimedOut())
+
+		history, err := sm.History(ctx, s.RunID())
+		require.NoError(t, err)
+		require.Equal(t, enums.HistoryTypeStepTimedOut, history[len(history)-1].Type)
+	})
+}
+
 func TestExecute_Generator(t *testing.T) {
 	ctx := context.Background()
 	sm := inmemory.NewStateManager()
@@ -231,11 +360,13 @@ func TestExecute_Generator(t *testing.T) {
 	responses := map[string]state.DriverResponse{
 		"step": {
 			Output: map[string]interface{}{"id": 1},
-			Generator: &state.GeneratorOpcode{
-				Op: enums.OpcodeStep,
-				ID: "1:some-id",
-				// JSON encoded string
-				Data: []byte(`"hello"`),
+			Generator: []*state.GeneratorOpcode{
+				{
+					Op: enums.OpcodeStep,
+					ID: "1:some-id",
+					// JSON encoded string
+					Data: []byte(`"hello"`),
+				},
 			},
 		},
 	}
@@ -277,10 +408,12 @@ func TestExecute_Generator(t *testing.T) {
 	driver.Responses = map[string]state.DriverResponse{
 		"step": {
 			Output: map[string]interface{}{"id": 1},
-			Generator: &state.GeneratorOpcode{
-				Op:   enums.OpcodeStep,
-				ID:   "2:another-id",
-				Data: byt,
+			Generator: []*state.GeneratorOpcode{
+				{
+					Op:   enums.OpcodeStep,
+					ID:   "2:another-id",
+					Data: byt,
+				},
 			},
 		},
 	}
@@ -1,23 +1,35 @@
 package executor
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
 	"github.com/inngest/inngest/inngest"
 	"github.com/inngest/inngest/pkg/config"
 	"github.com/inngest/inngest/pkg/coredata"
 	inmemorydatastore "github.com/inngest/inngest/pkg/coredata/inmemory"
 	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/event"
 	"github.com/inngest/inngest/pkg/execution/driver"
 	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/runner"
 	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/function"
 	"github.com/inngest/inngest/pkg/function/env"
 	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/metrics"
+	"github.com/inngest/inngest/pkg/pubsub"
 	"github.com/inngest/inngest/pkg/service"
+	"github.com/oklog/ulid/v2"
+	"github.com/uber-go/tally/v4"
 	"github.com/xhit/go-str2duration/v2"
 )
 
@@ -48,8 +60,18 @@ func WithQueue(q queue.Queue) func(s *svc) {
 	}
 }
 
+// WithPublisher sets the publisher used to send events from functions.
+func WithPublisher(p pubsub.Publisher) func(s *svc) {
+	return func(s *svc) {
+		s.publisher = p
+	}
+}
+
 func NewService(c config.Config, opts ...Opt) service.Service {
-	svc := &svc{config: c}
+	svc := &svc{
+		config:  c,
+		metrics: metrics.Scope().SubScope("executor"),
+	}
 	for _, o := range opts {
 		o(svc)
 	}
@@ -64,10 +86,14 @@ type svc struct {
 	state state.Manager
 	// queue allows us to enqueue next steps.
 	queue queue.Queue
+	// publisher publishes events sent from functions to the event stream.
+	publisher pubsub.Publisher
 	// exec runs the specific actions.
 	exec Executor
 	// envreader allows reading .env variables for each function.
 	envreader env.EnvReader
+	// metrics reports run statuses as runs finish.
+	metrics tally.Scope
 
 	wg sync.WaitGroup
 }
@@ -103,6 +129,13 @@ func (s *svc) Pre(ctx context.Context) error {
 		}
 	}
 
+	// The state store invokes callbacks once per status transition, so
+	// finished runs are counted and resume their invoking run exactly once
+	// regardless of how many steps race to finalize the run.
+	if n, ok := s.state.(state.FunctionNotifier); ok {
+		n.OnFunctionStatus(s.runFinished)
+	}
+
 	if s.queue == nil {
 		logger.From(ctx).Info().Str("backend", s.config.Queue.Service.Backend).Msg("starting queue")
 		s.queue, err = s.config.Queue.Service.Concrete.Queue()
@@ -111,6 +144,13 @@ func (s *svc) Pre(ctx context.Context) error {
 		}
 	}
 
+	if s.publisher == nil {
+		s.publisher, err = pubsub.NewPublisher(ctx, s.config.EventStream.Service)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create drivers based off of the available config.  If we have no docker steps,
 	// don't initialize the docker driver.  This makes it easy for users to get started
 	// using the SDK with HTTP drivers only.
@@ -171,6 +211,12 @@ func (s *svc) Run(ctx context.Context) error {
 			err = s.handleQueueItem(ctx, item)
 		case queue.KindPause:
 			err = s.handlePauseTimeout(ctx, item)
+		case queue.KindDebounce:
+			err = s.handleDebounce(ctx, item)
+		case queue.KindTimeout:
+			err = s.handleTimeout(ctx, item)
+		case queue.KindInvokeFinished:
+			err = s.handleInvokeFinished(ctx, item)
 		default:
 			err = fmt.Errorf("unknown payload type: %T", item.Payload)
 		}
@@ -197,7 +243,13 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 
 	l.Debug().Interface("edge", edge).Msg("processing step")
 
-	resp, err := s.exec.Execute(ctx, item.Identifier, edge.Incoming, item.Attempt)
+	execCtx := ctx
+	if edge.IncomingGeneratorStep != "" {
+		// Invoke the function to run the given planned step only.
+		execCtx = driver.WithGeneratorStep(ctx, edge.IncomingGeneratorStep)
+	}
+
+	resp, err := s.exec.Execute(execCtx, item.Identifier, edge.Incoming, item.Attempt)
 
 	// Check if the execution is cancelled, and if so finalize and terminate early.
 	// This prevents steps from scheduling children.
@@ -229,8 +281,17 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 		return nil
 	}
 
+	// If this is a planned step, the function is re-invoked only once every
+	// step planned alongside it has reported.
+	if edge.IncomingGeneratorStep != "" {
+		if err := s.reportPlannedStep(ctx, item, resp); err != nil {
+			return fmt.Errorf("unable to report planned step: %w", err)
+		}
+		return s.state.Finalized(ctx, item.Identifier, edge.Incoming, item.Attempt)
+	}
+
 	// If this is a generator step, we need to re-invoke the current function.
-	if resp != nil && resp.Generator != nil {
+	if resp != nil && len(resp.Generator) > 0 {
 		// We're re-invoking the current step again.  Generator steps do not have
 		// their own "step output" until the end of the function;  instead, each
 		// sub-step within the generator yields a new output with its own step ID.
@@ -258,7 +319,42 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 
 	l.Trace().Int("len", len(children)).Msg("evaluated children")
 
+	if err := s.scheduleChildren(ctx, item, run, edge.Incoming, children); err != nil {
+		return err
+	}
+
+	// Mark this step as finalized.
+	//
+	// This must happen after everything is enqueued, else the scheduled <> finalized count
+	// is out of order.
+	if err := s.state.Finalized(ctx, item.Identifier, edge.Incoming, item.Attempt); err != nil {
+		return fmt.Errorf("unable to finalize step: %w", err)
+	}
+
+	l.Debug().Interface("edge", edge).Msg("step complete")
+	return nil
+}
+
+// scheduleChildren schedules the given child edges of the given step, saving
+// pauses for async edges and enqueueing all other edges.
+func (s *svc) scheduleChildren(ctx context.Context, item queue.Item, run state.State, stepID string, children []inngest.Edge) error {
+	l := logger.From(ctx).With().Str("run_id", item.Identifier.RunID.String()).Logger()
+
 	for _, next := range children {
+		if next.Metadata != nil && len(next.Metadata.Join) > 0 {
+			// Joins run once every step within the join has finished or been
+			// skipped.  Every report seeing the completed join schedules the
+			// join, which is enqueued once via its job ID below.
+			complete, err := s.state.Reported(ctx, item.Identifier, joinGroupID(next.Incoming), next.Outgoing, len(next.Metadata.Join))
+			if err != nil {
+				return fmt.Errorf("unable to report join step: %w", err)
+			}
+			if !complete {
+				l.Debug().Interface("edge", next).Msg("waiting for join")
+				continue
+			}
+		}
+
 		// We want to wait for another event to come in to traverse this edge within the DAG.
 		//
 		// Create a new "pause", which informs the state manager that we're pausing the traversal
@@ -288,7 +384,7 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 			l.Debug().Interface("edge", next).Msg("saving pause")
 			pauseID := uuid.New()
 			expires := time.Now().Add(dur)
-			err = s.state.SavePause(ctx, state.Pause{
+			pause := state.Pause{
 				ID:         pauseID,
 				Identifier: run.Identifier(),
 				Outgoing:   next.Outgoing,
@@ -297,7 +393,13 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 				Event:      &am.Event,
 				Expression: am.Match,
 				OnTimeout:  am.OnTimeout,
-			})
+			}
+			if err := pause.PrepareExpression(ctx, state.EdgeExpressionData(ctx, run, next.Outgoing)); err != nil {
+				// Save the pause regardless;  the runner loads state to evaluate
+				// unprepared expressions.
+				l.Warn().Err(err).Msg("error preparing edge pause expression")
+			}
+			err = s.state.SavePause(ctx, pause)
 			if err != nil {
 				return fmt.Errorf("error saving edge pause: %w", err)
 			}
@@ -321,7 +423,7 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 
 		at := time.Now()
 		if next.Metadata != nil && next.Metadata.Wait != nil {
-			dur, err := ParseWait(ctx, *next.Metadata.Wait, run, edge.Incoming)
+			dur, err := ParseWait(ctx, *next.Metadata.Wait, run, stepID)
 			if err != nil {
 				return fmt.Errorf("unable to parse wait: %w", err)
 			}
@@ -330,12 +432,25 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 
 		l.Debug().Str("outgoing", next.Outgoing).Time("at", at).Msg("scheduling next step")
 
+		child := queue.Item{
+			Kind:        queue.KindEdge,
+			Identifier:  item.Identifier,
+			Payload:     queue.PayloadEdge{Edge: next},
+			Backoff:     queue.EdgeBackoff(run.Workflow(), next),
+			MaxDuration: queue.EdgeMaxDuration(run.Workflow(), next),
+		}
+
+		if next.Metadata != nil && len(next.Metadata.Join) > 0 {
+			// Retrying a step within the join may schedule the join again, so
+			// the join is enqueued once using a deterministic job ID.
+			if err := s.enqueueOnce(ctx, child, next.Incoming, jobID(item.Identifier, joinGroupID(next.Incoming)), at); err != nil {
+				return fmt.Errorf("unable to enqueue join: %w", err)
+			}
+			continue
+		}
+
 		// Enqueue the next child in our queue.
-		if err := s.queue.Enqueue(ctx, queue.Item{
-			Kind:       queue.KindEdge,
-			Identifier: item.Identifier,
-			Payload:    queue.PayloadEdge{Edge: next},
-		}, at); err != nil {
+		if err := s.queue.Enqueue(ctx, child, at); err != nil {
 			return fmt.Errorf("unable to enqueue next step: %w", err)
 		}
 
@@ -354,20 +469,11 @@ func (s *svc) handleQueueItem(ctx context.Context, item queue.Item) error {
 		}
 	}
 
-	// Mark this step as finalized.
-	//
-	// This must happen after everything is enqueued, else the scheduled <> finalized count
-	// is out of order.
-	if err := s.state.Finalized(ctx, item.Identifier, edge.Incoming, item.Attempt); err != nil {
-		return fmt.Errorf("unable to finalize step: %w", err)
-	}
-
-	l.Debug().Interface("edge", edge).Msg("step complete")
 	return nil
 }
 
 func (s *svc) scheduleGeneratorResponse(ctx context.Context, item queue.Item, r *state.DriverResponse) error {
-	if r.Generator == nil {
+	if len(r.Generator) == 0 {
 		return fmt.Errorf("unable to handle non-generator response")
 	}
 
@@ -376,11 +482,36 @@ func (s *svc) scheduleGeneratorResponse(ctx context.Context, item queue.Item, r
 		return fmt.Errorf("unknown queue item type handling generator: %T", item.Payload)
 	}
 
-	switch r.Generator.Op {
+	planned := []*state.GeneratorOpcode{}
+	for _, gen := range r.Generator {
+		switch gen.Op {
+		case enums.OpcodeStepPlanned:
+			planned = append(planned, gen)
+		case enums.OpcodeStep:
+			// The executor has already saved the output of steps which ran.
+		default:
+			if len(r.Generator) > 1 {
+				return fmt.Errorf("unable to handle opcode %s with other opcodes", gen.Op.String())
+			}
+		}
+	}
+	if len(planned) > 0 {
+		return s.schedulePlannedSteps(ctx, item, edge, planned)
+	}
+
+	// Every opcode is handled in the same way as the first:  either this is
+	// a single opcode, or many steps ran and the function must be re-invoked
+	// once.
+	return s.scheduleGeneratorOpcode(ctx, item, edge, r.Generator[0])
+}
+
+// scheduleGeneratorOpcode handles a single opcode returned from a generator.
+func (s *svc) scheduleGeneratorOpcode(ctx context.Context, item queue.Item, edge queue.PayloadEdge, gen *state.GeneratorOpcode) error {
+	switch gen.Op {
 	case enums.OpcodeNone:
 		return nil
 	case enums.OpcodeWaitForEvent:
-		opts, err := r.Generator.WaitForEventOpts()
+		opts, err := gen.WaitForEventOpts()
 		if err != nil {
 			return fmt.Errorf("unable to parse wait for event opts: %w", err)
 		}
@@ -396,16 +527,30 @@ func (s *svc) scheduleGeneratorResponse(ctx context.Context, item queue.Item, r
 		}
 
 		pauseID := uuid.New()
-		err = s.state.SavePause(ctx, state.Pause{
+		pause := state.Pause{
 			ID:         pauseID,
-			DataKey:    r.Generator.ID,
+			DataKey:    gen.ID,
 			Identifier: item.Identifier,
 			Outgoing:   edge.Edge.Outgoing,
 			Incoming:   edge.Edge.Incoming,
 			Expires:    state.Time(expires),
 			Event:      &opts.Event,
 			Expression: opts.If,
-		})
+		}
+		if opts.If != nil {
+			// Store the data that the expression references within the pause,
+			// so that events don't need to load state to evaluate the pause.
+			run, err := s.state.Load(ctx, item.Identifier.RunID)
+			if err != nil {
+				return fmt.Errorf("unable to load run: %w", err)
+			}
+			if err := pause.PrepareExpression(ctx, state.EdgeExpressionData(ctx, run, edge.Edge.Outgoing)); err != nil {
+				// Save the pause regardless;  the runner loads state to evaluate
+				// unprepared expressions.
+				logger.From(ctx).Warn().Err(err).Msg("error preparing wait for event expression")
+			}
+		}
+		err = s.state.SavePause(ctx, pause)
 		if err != nil {
 			return err
 		}
@@ -422,9 +567,20 @@ func (s *svc) scheduleGeneratorResponse(ctx context.Context, item queue.Item, r
 				OnTimeout: true,
 			},
 		}, expires)
+	case enums.OpcodeInvokeFunction:
+		return s.invokeFunction(ctx, item, edge, gen)
+	case enums.OpcodeSendEvent:
+		if err := s.sendEvents(ctx, item, gen); err != nil {
+			return err
+		}
+		// Re-enqueue the exact same edge to continue now.
+		if err := s.state.Scheduled(ctx, item.Identifier, edge.Edge.Incoming, 0, nil); err != nil {
+			return err
+		}
+		return s.queue.Enqueue(ctx, item, time.Now())
 	case enums.OpcodeSleep:
 		// Re-enqueue the exact same edge after a sleep.
-		dur, err := r.Generator.SleepDuration()
+		dur, err := gen.SleepDuration()
 		if err != nil {
 			return err
 		}
@@ -443,7 +599,380 @@ func (s *svc) scheduleGeneratorResponse(ctx context.Context, item queue.Item, r
 	}
 
 	// Enqueue the next child in our queue.
-	return fmt.Errorf("unknown opcode: %s", r.Generator.Op.String())
+	return fmt.Errorf("unknown opcode: %s", gen.Op.String())
+}
+
+// invokeFunction starts a run of the function specified by the opcode, pausing the
+// current run until the invoked run finishes or the invocation times out.  The
+// invoked run's output or error is stored as the output of the opcode's step.
+func (s *svc) invokeFunction(ctx context.Context, item queue.Item, edge queue.PayloadEdge, gen *state.GeneratorOpcode) error {
+	opts, err := gen.InvokeFunctionOpts()
+	if err != nil {
+		return fmt.Errorf("unable to parse invoke function opts: %w", err)
+	}
+	expires, err := opts.Expires()
+	if err != nil {
+		return fmt.Errorf("unable to parse invoke function expires: %w", err)
+	}
+
+	run, err := s.state.Load(ctx, item.Identifier.RunID)
+	if err != nil {
+		return fmt.Errorf("unable to load run: %w", err)
+	}
+	if run.ActionComplete(gen.ID) {
+		// The invoked run has already finished and resumed this run.
+		return nil
+	}
+
+	pause := state.Pause{
+		ID:         invokePauseID(item.Identifier, gen.ID),
+		DataKey:    gen.ID,
+		Identifier: item.Identifier,
+		Outgoing:   edge.Edge.Outgoing,
+		Incoming:   edge.Edge.Incoming,
+		Expires:    state.Time(expires),
+	}
+	existing, err := s.state.PauseByID(ctx, pause.ID)
+	switch {
+	case err == nil && existing != nil:
+		// The pause was saved when previously attempting this step.
+		pause = *existing
+	case err == nil || err == state.ErrPauseNotFound:
+		// The pause's edge is outstanding until the invoked run finishes.
+		if err := s.state.Scheduled(ctx, item.Identifier, edge.Edge.Incoming, 0, nil); err != nil {
+			return fmt.Errorf("unable to schedule invoke function: %w", err)
+		}
+		if err := s.state.SavePause(ctx, pause); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unable to load invoke pause: %w", err)
+	}
+
+	l := logger.From(ctx).With().
+		Str("run_id", item.Identifier.RunID.String()).
+		Str("function", opts.FunctionID).
+		Logger()
+
+	fns, err := s.data.Functions(ctx)
+	if err != nil {
+		return err
+	}
+	var fn *function.Function
+	for n := range fns {
+		if fns[n].ID == opts.FunctionID {
+			fn = &fns[n]
+			break
+		}
+	}
+	if fn == nil {
+		l.Warn().Msg("invoked function not found")
+		return s.resumeInvoke(ctx, pause, map[string]any{
+			"error": fmt.Sprintf("function not found: %s", opts.FunctionID),
+		})
+	}
+
+	// The event's ID is deterministic such that retrying this step never
+	// invokes the function again.
+	evt := event.Event{
+		ID:        stepEventID(item.Identifier, gen.ID, 0),
+		Name:      runner.InvokeEventName,
+		Data:      opts.Payload,
+		Timestamp: int64(item.Identifier.RunID.Time()),
+	}
+	if evt.Data == nil {
+		evt.Data = map[string]any{}
+	}
+
+	l.Info().Msg("invoking fn")
+	_, err = runner.Invoke(ctx, *fn, evt, pause.ID, s.state, s.queue)
+	switch err {
+	case nil, state.ErrIdentifierExists:
+		// The function was invoked when previously attempting this step.
+	case runner.ErrFunctionSkipped:
+		// The invoked run will never start, so resume immediately.
+		return s.resumeInvoke(ctx, pause, map[string]any{
+			"error": "the invoked function run was skipped",
+		})
+	default:
+		return err
+	}
+
+	// Time out the invocation if the invoked run doesn't finish in time.  As with
+	// waiting for events, only one of the run finishing or the timeout is able to
+	// consume the pause.
+	return s.queue.Enqueue(ctx, queue.Item{
+		Kind:       queue.KindPause,
+		Identifier: item.Identifier,
+		Payload: queue.PayloadPauseTimeout{
+			PauseID:   pause.ID,
+			OnTimeout: true,
+		},
+	}, expires)
+}
+
+// sendEvents publishes the events sent by a function to the event stream, storing
+// the events' IDs as the output of the opcode's step.
+//
+// Each event's ID is deterministic for the run, step and event, such that retries
+// never send new events.  If the step's output is stored, the events have already
+// been sent and are not published again.
+func (s *svc) sendEvents(ctx context.Context, item queue.Item, gen *state.GeneratorOpcode) error {
+	opts, err := gen.SendEventOpts()
+	if err != nil {
+		return fmt.Errorf("unable to parse send event opts: %w", err)
+	}
+
+	run, err := s.state.Load(ctx, item.Identifier.RunID)
+	if err != nil {
+		return fmt.Errorf("unable to load run: %w", err)
+	}
+	if run.ActionComplete(gen.ID) {
+		return nil
+	}
+
+	ids := make([]string, len(opts.Events))
+	for n, evt := range opts.Events {
+		evt.ID = stepEventID(item.Identifier, gen.ID, n)
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		if evt.Timestamp == 0 {
+			// Use the run's start time, such that the event is identical
+			// when re-sent by a retried step.
+			evt.Timestamp = int64(item.Identifier.RunID.Time())
+		}
+
+		byt, err := json.Marshal(evt)
+		if err != nil {
+			return fmt.Errorf("error marshalling event: %w", err)
+		}
+
+		logger.From(ctx).Info().
+			Str("run_id", item.Identifier.RunID.String()).
+			Str("event_name", evt.Name).
+			Str("id", evt.ID).
+			Msg("publishing event")
+
+		err = s.publisher.Publish(
+			ctx,
+			s.config.EventStream.Service.TopicName(),
+			pubsub.Message{
+				Name:      event.EventReceivedName,
+				Data:      string(byt),
+				Timestamp: time.Now(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("error publishing event: %w", err)
+		}
+		ids[n] = evt.ID
+	}
+
+	_, err = s.state.SaveResponse(ctx, item.Identifier, state.DriverResponse{
+		Step:   inngest.Step{ID: gen.ID, Name: gen.Name},
+		Output: map[string]any{"ids": ids},
+	}, 0)
+	return err
+}
+
+// stepEventID returns the ID for the n-th event sent or invoked by the given run's
+// step.  IDs are ULIDs using the run ID's timestamp, with entropy derived from the
+// run, step and index.
+func stepEventID(id state.Identifier, stepID string, n int) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%s:%d", id.RunID, stepID, n)))
+	return ulid.MustNew(id.RunID.Time(), bytes.NewReader(hash[:])).String()
+}
+
+// invokePauseID returns the ID of the pause which awaits the function invoked by
+// the given run's step.
+func invokePauseID(id state.Identifier, stepID string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s:%s", id.RunID, stepID)))
+}
+
+// handleInvokeFinished resumes the run which invoked the item's run, using the
+// item's run's result.  These items are enqueued by runner.InvokeFinished once an
+// invoked run finishes.
+func (s *svc) handleInvokeFinished(ctx context.Context, item queue.Item) error {
+	run, err := s.state.Load(ctx, item.Identifier.RunID)
+	if err != nil {
+		return fmt.Errorf("unable to load run: %w", err)
+	}
+	pauseID, ok := run.Metadata().InvokePauseID()
+	if !ok {
+		return nil
+	}
+
+	pause, err := s.state.PauseByID(ctx, pauseID)
+	if err == state.ErrPauseNotFound || (err == nil && pause == nil) {
+		// The invoking run has already been resumed, timed out, or been
+		// cancelled.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to load invoke pause: %w", err)
+	}
+
+	history, err := s.state.History(ctx, item.Identifier.RunID)
+	if err != nil {
+		return fmt.Errorf("unable to load history: %w", err)
+	}
+	return s.resumeInvoke(ctx, *pause, invokeResult(run, history))
+}
+
+// resumeInvoke resumes the run awaiting an invoked function with the given result.
+func (s *svc) resumeInvoke(ctx context.Context, pause state.Pause, result map[string]any) error {
+	err := runner.ResumePause(ctx, s.state, s.queue, pause, result)
+	if err == state.ErrPauseLeased || err == state.ErrPauseNotFound {
+		// The pause is being resumed by its timeout.
+		return nil
+	}
+	return err
+}
+
+// invokeResult returns the data used to resume the run which invoked the given,
+// finished run.  Successful runs resume with {"data": output}, where output is the
+// output of the function's step, or of every step for multi-step functions.  Runs
+// which fail or are cancelled resume with {"error": message}, using the error of
+// the step which failed the run.
+func invokeResult(run state.State, history []state.History) map[string]any {
+	switch run.Metadata().Status {
+	case enums.RunStatusCancelled:
+		return map[string]any{"error": "the invoked function run was cancelled"}
+	case enums.RunStatusFailed:
+		msg := "the invoked function run failed"
+		for _, h := range history {
+			if h.Type != enums.HistoryTypeStepFailed {
+				continue
+			}
+			if step, ok := h.Data.(state.HistoryStep); ok {
+				if str, ok := step.Data.(string); ok && str != "" {
+					msg = str
+				}
+			}
+		}
+		return map[string]any{"error": msg}
+	}
+
+	steps := run.Workflow().Steps
+	if len(steps) == 1 {
+		output, _ := run.ActionID(steps[0].ID)
+		return map[string]any{"data": output}
+	}
+	return map[string]any{"data": run.Actions()}
+}
+
+// schedulePlannedSteps enqueues each step planned by a generator so that the steps
+// run in parallel.  Each step runs by invoking the function with the step's ID.
+func (s *svc) schedulePlannedSteps(ctx context.Context, item queue.Item, edge queue.PayloadEdge, planned []*state.GeneratorOpcode) error {
+	group := &queue.PlannedGroup{}
+	for _, gen := range planned {
+		group.Steps = append(group.Steps, gen.ID)
+	}
+	// The group's ID is deterministic, such that retrying this step reports
+	// planned steps within the same group.
+	group.ID = fmt.Sprintf("%s:%s", edge.Edge.Incoming, strings.Join(group.Steps, ","))
+
+	for _, gen := range planned {
+		next := edge.Edge
+		next.IncomingGeneratorStep = gen.ID
+
+		step := item
+		step.Attempt = 0
+		step.Payload = queue.PayloadEdge{Edge: next, Group: group}
+		if err := s.enqueueOnce(ctx, step, gen.ID, jobID(item.Identifier, group.ID, gen.ID), time.Now()); err != nil {
+			return fmt.Errorf("unable to enqueue planned step: %w", err)
+		}
+	}
+	return nil
+}
+
+// reportPlannedStep records that a planned step has run.  Once every step within
+// the step's group has reported, the function is re-invoked to continue.
+func (s *svc) reportPlannedStep(ctx context.Context, item queue.Item, resp *state.DriverResponse) error {
+	edge, ok := item.Payload.(queue.PayloadEdge)
+	if !ok || edge.Group == nil {
+		return fmt.Errorf("no group found for planned step")
+	}
+
+	stepID := edge.Edge.IncomingGeneratorStep
+	if !ranStep(resp, stepID) {
+		return fmt.Errorf("planned step did not run: %s", stepID)
+	}
+
+	complete, err := s.state.Reported(ctx, item.Identifier, edge.Group.ID, stepID, len(edge.Group.Steps))
+	if err != nil {
+		return err
+	}
+	if !complete {
+		// Other steps are still running.
+		return nil
+	}
+
+	// Every report seeing the completed group re-invokes the function, such
+	// that retrying a report which failed to re-invoke continues the run.  The
+	// job ID ensures the function is only re-invoked once.
+	next := edge.Edge
+	next.IncomingGeneratorStep = ""
+
+	invoke := item
+	invoke.Attempt = 0
+	invoke.Payload = queue.PayloadEdge{Edge: next}
+	return s.enqueueOnce(ctx, invoke, next.Incoming, jobID(item.Identifier, edge.Group.ID, "invoke"), time.Now())
+}
+
+// enqueueOnce increases the run's scheduled count for the given step then enqueues
+// the item with the given job ID.  If the item isn't enqueued, either as it fails or
+// as a previous attempt already enqueued the item, the scheduled count is reverted.
+// This ensures that retrying a step which failed part way through scheduling never
+// schedules the same item twice.
+func (s *svc) enqueueOnce(ctx context.Context, item queue.Item, stepID string, jobID string, at time.Time) error {
+	// The step is scheduled before it's enqueued, which ensures that the run
+	// doesn't complete while the step is running.
+	if err := s.state.Scheduled(ctx, item.Identifier, stepID, 0, &at); err != nil {
+		return fmt.Errorf("unable to schedule step: %w", err)
+	}
+
+	item.JobID = &jobID
+	err := s.queue.Enqueue(ctx, item, at)
+	if err == nil {
+		return nil
+	}
+	if ferr := s.state.Finalized(ctx, item.Identifier, stepID, 0); ferr != nil {
+		return fmt.Errorf("unable to revert scheduled step: %w", ferr)
+	}
+	if err == queue.ErrQueueItemExists {
+		return nil
+	}
+	return fmt.Errorf("error enqueueing step: %w", err)
+}
+
+// jobID returns a deterministic queue job ID for an item within the given run.
+func jobID(id state.Identifier, parts ...string) string {
+	return fmt.Sprintf("%s:%s", id.RunID, strings.Join(parts, ":"))
+}
+
+// joinGroupID returns the ID used to report the steps within the join which runs
+// the given step.
+func joinGroupID(stepID string) string {
+	return fmt.Sprintf("join:%s", stepID)
+}
+
+// ranStep returns whether the response is from running the given generator step.
+// Responses without opcodes are returned when the step's output was already saved.
+func ranStep(resp *state.DriverResponse, stepID string) bool {
+	if resp == nil {
+		return false
+	}
+	if len(resp.Generator) == 0 {
+		return true
+	}
+	for _, gen := range resp.Generator {
+		if gen.Op == enums.OpcodeStep && gen.ID == stepID {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *svc) handlePauseTimeout(ctx context.Context, item queue.Item) error {
@@ -473,17 +1002,36 @@ func (s *svc) handlePauseTimeout(ctx context.Context, item queue.Item) error {
 
 	if pauseTimeout.OnTimeout {
 		l.Info().Interface("pause", pauseTimeout).Interface("edge", pause.Edge()).Msg("scheduling pause timeout step")
+		run, err := s.state.Load(ctx, item.Identifier.RunID)
+		if err != nil {
+			return fmt.Errorf("unable to load run: %w", err)
+		}
 		// Enqueue the next job to run.  We could handle this in the
 		// same thread, but its safer to enable retries by re-enqueueing.
 		if err := s.queue.Enqueue(ctx, queue.Item{
-			Kind:       queue.KindEdge,
-			Identifier: item.Identifier,
-			Payload:    queue.PayloadEdge{Edge: pause.Edge()},
+			Kind:        queue.KindEdge,
+			Identifier:  item.Identifier,
+			Payload:     queue.PayloadEdge{Edge: pause.Edge()},
+			Backoff:     queue.EdgeBackoff(run.Workflow(), pause.Edge()),
+			MaxDuration: queue.EdgeMaxDuration(run.Workflow(), pause.Edge()),
 		}, time.Now()); err != nil {
 			return fmt.Errorf("error enqueueing timeout step: %w", err)
 		}
 	} else {
 		l.Info().Interface("pause", pauseTimeout).Interface("edge", pause.Edge()).Msg("ignoring pause timeout")
+		run, err := s.state.Load(ctx, item.Identifier.RunID)
+		if err != nil {
+			return fmt.Errorf("unable to load run: %w", err)
+		}
+		// The edge's step is skipped, so any joins after the step must still
+		// be reported.
+		joins, err := state.SkippedJoins(ctx, run, pause.Outgoing, pause.Incoming)
+		if err != nil {
+			return fmt.Errorf("unable to evaluate skipped joins: %w", err)
+		}
+		if err := s.scheduleChildren(ctx, item, run, pause.Incoming, joins); err != nil {
+			return err
+		}
 		// Finalize this action without it running.
 		if err := s.state.Finalized(ctx, item.Identifier, pause.Edge().Incoming, 0); err != nil {
 			return err
@@ -493,6 +1041,111 @@ func (s *svc) handlePauseTimeout(ctx context.Context, item queue.Item) error {
 	return nil
 }
 
+// handleDebounce starts a debounced function run with the debounce's last event,
+// if the debounce hasn't been replaced by a newer event since the item was enqueued.
+func (s *svc) handleDebounce(ctx context.Context, item queue.Item) error {
+	payload, ok := item.Payload.(queue.PayloadDebounce)
+	if !ok {
+		return fmt.Errorf("unable to get debounce from queue item: %T", item.Payload)
+	}
+
+	l := logger.From(ctx).With().Str("debounce_key", payload.Key).Logger()
+
+	d, err := s.state.ConsumeDebounce(ctx, payload.Key, payload.DebounceID)
+	if err == state.ErrDebounceNotFound {
+		// A newer event replaced this debounce, and will start the run.
+		l.Debug().Str("debounce_id", payload.DebounceID.String()).Msg("replaced debounce ignored")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.initializeDebounce(ctx, *d); err != nil {
+		// Restore the debounce so that it's consumed when this item is retried.
+		if rerr := s.state.RestoreDebounce(ctx, *d); rerr != nil {
+			err = multierror.Append(err, fmt.Errorf("unable to restore debounce: %w", rerr))
+		}
+		return err
+	}
+	return nil
+}
+
+// initializeDebounce starts a run of the debounced function with the debounce's
+// event.
+func (s *svc) initializeDebounce(ctx context.Context, d state.Debounce) error {
+	l := logger.From(ctx).With().Str("debounce_key", d.Key).Logger()
+
+	fns, err := s.data.Functions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, fn := range fns {
+		if fn.ID != d.FunctionID {
+			continue
+		}
+		l.Info().Str("function", fn.ID).Msg("initializing debounced fn")
+		_, err := runner.Initialize(ctx, fn, d.Event, s.state, s.queue)
+		if err == runner.ErrFunctionSkipped || err == state.ErrIdentifierExists {
+			return nil
+		}
+		return err
+	}
+
+	l.Warn().Str("function", d.FunctionID).Msg("debounced function not found")
+	return nil
+}
+
+// handleTimeout cancels a run which has exceeded one of its function's timeouts.
+// Run timeouts cancel any run which is still running, and start timeouts cancel
+// runs whose first step hasn't started.
+func (s *svc) handleTimeout(ctx context.Context, item queue.Item) error {
+	payload, ok := item.Payload.(queue.PayloadTimeout)
+	if !ok {
+		return fmt.Errorf("unable to get timeout from queue item: %T", item.Payload)
+	}
+
+	l := logger.From(ctx).With().
+		Str("run_id", item.Identifier.RunID.String()).
+		Str("timeout", payload.Timeout).
+		Logger()
+
+	run, err := s.state.Load(ctx, item.Identifier.RunID)
+	if err != nil {
+		return fmt.Errorf("unable to load run: %w", err)
+	}
+	if run.Metadata().Status != enums.RunStatusRunning {
+		return nil
+	}
+
+	if payload.Timeout == queue.TimeoutStart {
+		history, err := s.state.History(ctx, item.Identifier.RunID)
+		if err != nil {
+			return fmt.Errorf("unable to load history: %w", err)
+		}
+		for _, h := range history {
+			if h.Type == enums.HistoryTypeStepStarted {
+				return nil
+			}
+		}
+	}
+
+	l.Info().Str("duration", payload.Duration).Msg("cancelling timed out run")
+	err = s.state.Cancel(ctx, run.Identifier(), state.HistoryFunctionCancelled{
+		Type: enums.CancellationTypeTimeout,
+		Data: payload,
+	})
+	switch err {
+	case nil:
+		// The invoking run, if any, is resumed by runFinished.
+		return nil
+	case state.ErrFunctionCancelled, state.ErrFunctionComplete, state.ErrFunctionFailed:
+		// The run may have finished since being loaded.
+		return nil
+	}
+	return fmt.Errorf("unable to cancel run: %w", err)
+}
+
 func (s *svc) hasDockerStep(ctx context.Context) (bool, error) {
 	fns, err := s.data.Functions(ctx)
 	if err != nil {
@@ -508,3 +1161,19 @@ func (s *svc) hasDockerStep(ctx context.Context) (bool, error) {
 	}
 	return false, nil
 }
+
+// runFinished reports the status of each run as it finishes, and resumes the run
+// which invoked it, if any.
+func (s *svc) runFinished(ctx context.Context, id state.Identifier, status enums.RunStatus) {
+	if status == enums.RunStatusRunning {
+		return
+	}
+	tags := map[string]string{"status": strings.ToLower(status.String())}
+	s.metrics.Tagged(tags).Counter("runs_finished").Inc(1)
+
+	// The state store runs this once per run, so failures can't be retried by
+	// retrying a step;  the invoking run's pause times out if this fails.
+	if err := runner.InvokeFinished(ctx, s.state, s.queue, id); err != nil {
+		logger.From(ctx).Error().Err(err).Str("run_id", id.RunID.String()).Msg("error finishing invoked run")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -17,12 +18,15 @@ import (
 	inmemorydatastore "github.com/inngest/inngest/pkg/coredata/inmemory"
 	"github.com/inngest/inngest/pkg/enums"
 	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/driver"
 	"github.com/inngest/inngest/pkg/execution/driver/mockdriver"
 	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/queue/inmemoryqueue"
+	"github.com/inngest/inngest/pkg/execution/runner"
 	"github.com/inngest/inngest/pkg/execution/state"
 	"github.com/inngest/inngest/pkg/execution/state/inmemory"
 	"github.com/inngest/inngest/pkg/function"
+	"github.com/inngest/inngest/pkg/pubsub"
 	"github.com/inngest/inngest/pkg/service"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
@@ -270,6 +274,113 @@ func TestHandleQueueItemTriggerService(t *testing.T) {
 	require.Equal(t, 0, run.Metadata().Pending)
 }
 
+// TestServiceDebounce ensures that debounced functions run once with the last event
+// received, after the debounce period.
+func TestServiceDebounce(t *testing.T) {
+	ctx := context.Background()
+
+	f := syncF
+	f.Debounce = &inngest.Debounce{Period: "200ms"}
+	data := prepare(ctx, t, f)
+	data.c.Execution.Drivers["mock"] = &mockdriver.Config{
+		Responses: map[string]state.DriverResponse{
+			"1": {Output: map[string]interface{}{"id": 1}},
+		},
+	}
+
+	svc := NewService(
+		*data.c,
+		WithExecutionLoader(data.al),
+		WithQueue(data.q),
+		WithState(data.sm),
+	)
+
+	go func() {
+		err := service.Start(ctx, svc)
+		require.NoError(t, err)
+	}()
+
+	for i := 1; i <= 3; i++ {
+		err := runner.Debounce(ctx, f, event.Event{
+			ID:   fmt.Sprintf("evt-%d", i),
+			Name: "test-evt",
+		}, data.sm, data.q)
+		require.NoError(t, err)
+		<-time.After(50 * time.Millisecond)
+	}
+
+	runs, err := data.sm.(inmemory.InmemoryLoader).Runs(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(runs), "Debounced runs must wait for the debounce period")
+
+	<-time.After(200*time.Millisecond + buffer)
+
+	runs, err = data.sm.(inmemory.InmemoryLoader).Runs(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(runs), "Debounced functions must run once")
+
+	runs, err = data.sm.(inmemory.InmemoryLoader).Runs(ctx, "evt-3")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(runs), "Debounced functions must run with the last event")
+}
+
+// TestServiceDebounceRetry ensures that debounces which fail to start their run
+// start the run when retried.
+func TestServiceDebounceRetry(t *testing.T) {
+	ctx := context.Background()
+
+	f := syncF
+	f.Debounce = &inngest.Debounce{Period: "200ms"}
+	data := prepare(ctx, t, f)
+	al := &failingLoader{ExecutionLoader: data.al}
+
+	s := NewService(
+		*data.c,
+		WithExecutionLoader(al),
+		WithQueue(data.q),
+		WithState(data.sm),
+	).(*svc)
+	require.NoError(t, s.Pre(ctx))
+
+	d := state.Debounce{
+		ID:         ulid.MustNew(ulid.Now(), rand.Reader),
+		Key:        "debounce-key",
+		FunctionID: f.ID,
+		Event:      event.Event{ID: "evt", Name: "test-evt"},
+		Period:     200 * time.Millisecond,
+	}
+	require.NoError(t, data.sm.SaveDebounce(ctx, d))
+	item := queue.Item{
+		Kind:    queue.KindDebounce,
+		Payload: queue.PayloadDebounce{Key: d.Key, DebounceID: d.ID},
+	}
+
+	atomic.StoreInt32(&al.failures, 1)
+	err := s.handleDebounce(ctx, item)
+	require.Error(t, err)
+
+	err = s.handleDebounce(ctx, item)
+	require.NoError(t, err)
+
+	runs, err := data.sm.(inmemory.InmemoryLoader).Runs(ctx, "evt")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(runs), "Retried debounces must start the run")
+}
+
+// failingLoader fails to load functions the given number of times.
+type failingLoader struct {
+	coredata.ExecutionLoader
+
+	failures int32
+}
+
+func (f *failingLoader) Functions(ctx context.Context) ([]function.Function, error) {
+	if atomic.AddInt32(&f.failures, -1) >= 0 {
+		return nil, fmt.Errorf("unable to load functions")
+	}
+	return f.ExecutionLoader.Functions(ctx)
+}
+
 // TestHandleAsync ensures correctness when hitting an async edge.  Technically,
 // once we hit an async edge we need to:
 //
@@ -427,11 +538,13 @@ func TestServiceGeneratorState(t *testing.T) {
 				// On the first call return a generator step
 				return map[string]state.DriverResponse{
 					"step": {
-						Generator: &state.GeneratorOpcode{
-							Op:   enums.OpcodeStep,
-							ID:   "step 1",
-							Name: "step 1",
-							Data: []byte(`{"ok":true}`),
+						Generator: []*state.GeneratorOpcode{
+							{
+								Op:   enums.OpcodeStep,
+								ID:   "step 1",
+								Name: "step 1",
+								Data: []byte(`{"ok":true}`),
+							},
 						},
 					},
 				}
@@ -439,11 +552,13 @@ func TestServiceGeneratorState(t *testing.T) {
 				// On the second call return a generator step
 				return map[string]state.DriverResponse{
 					"step": {
-						Generator: &state.GeneratorOpcode{
-							Op:   enums.OpcodeStep,
-							ID:   "step 2",
-							Name: "step 2",
-							Data: []byte(`{"ok":true}`),
+						Generator: []*state.GeneratorOpcode{
+							{
+								Op:   enums.OpcodeStep,
+								ID:   "step 2",
+								Name: "step 2",
+								Data: []byte(`{"ok":true}`),
+							},
 						},
 					},
 				}
@@ -511,31 +626,66 @@ func TestServiceGeneratorState(t *testing.T) {
 	})
 }
 
-func TestServiceRetry(t *testing.T) {
+// TestServiceGeneratorPlannedSteps asserts that steps planned in parallel by a generator
+// each run independently, and that the function is re-invoked once after every planned
+// step has reported.
+func TestServiceGeneratorPlannedSteps(t *testing.T) {
 	ctx := context.Background()
 	data := prepare(ctx, t, genF)
 	require.NotNil(t, data)
 
-	var counter int32
+	var (
+		invocations int32
+		lock        sync.Mutex
+		ran         []string
+		// reinvoked stores the step outputs available when the function is
+		// re-invoked.
+		reinvoked map[string]any
+	)
 
-	// Ensure our step returns a generator response.
 	data.c.Execution.Drivers["mock"] = &mockdriver.Config{
 		DynamicResponses: func(ctx context.Context, run state.State, av inngest.ActionVersion, s inngest.Step) map[string]state.DriverResponse {
-			switch atomic.AddInt32(&counter, 1) {
-			case 1:
-				// Error first.
+			if id := driver.GeneratorStep(ctx); id != "" {
+				// Run the planned step only.
+				lock.Lock()
+				ran = append(ran, id)
+				lock.Unlock()
 				return map[string]state.DriverResponse{
 					"step": {
-						Err: fmt.Errorf("failure"),
+						Generator: []*state.GeneratorOpcode{
+							{
+								Op:   enums.OpcodeStep,
+								ID:   id,
+								Name: id,
+								Data: []byte(`{"ok":true}`),
+							},
+						},
 					},
 				}
+			}
+
+			switch atomic.AddInt32(&invocations, 1) {
+			case 1:
+				// Plan three steps to run in parallel.
+				planned := []*state.GeneratorOpcode{}
+				for _, id := range []string{"a", "b", "c"} {
+					planned = append(planned, &state.GeneratorOpcode{
+						Op:   enums.OpcodeStepPlanned,
+						ID:   id,
+						Name: id,
+					})
+				}
+				return map[string]state.DriverResponse{
+					"step": {Generator: planned},
+				}
 			default:
+				lock.Lock()
+				reinvoked = run.Actions()
+				lock.Unlock()
 				return map[string]state.DriverResponse{
 					"step": {},
 				}
-
 			}
-
 		},
 	}
 
@@ -550,44 +700,1042 @@ func TestServiceRetry(t *testing.T) {
 		require.NoError(t, err)
 	}()
 
-	var id state.Identifier
-	t.Run("Create a new run", func(t *testing.T) {
-		// Create a new run.
-		id = state.Identifier{
-			WorkflowID: data.w.UUID,
-			RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
-		}
-		_, err := data.sm.New(ctx, state.Input{
-			Workflow:   data.w,
-			Identifier: id,
-			EventData: (event.Event{
-				Name: "test",
-				Data: map[string]interface{}{
-					"data": "ya",
+	id := state.Identifier{
+		WorkflowID: data.w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err := data.sm.New(ctx, state.Input{
+		Workflow:   data.w,
+		Identifier: id,
+		EventData: (event.Event{
+			Name: "test",
+			Data: map[string]interface{}{
+				"data": "ya",
+			},
+		}).Map(),
+	})
+	require.NoError(t, err)
+
+	err = data.q.Enqueue(ctx, queue.Item{
+		Kind:       queue.KindEdge,
+		Identifier: id,
+		Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
+	}, time.Now())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&invocations) >= 2
+	}, 10*buffer, 10*time.Millisecond)
+	<-time.After(buffer)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	require.ElementsMatch(t, []string{"a", "b", "c"}, ran)
+	require.EqualValues(t, 2, atomic.LoadInt32(&invocations), "the function should be re-invoked once")
+	require.Contains(t, reinvoked, "a")
+	require.Contains(t, reinvoked, "b")
+	require.Contains(t, reinvoked, "c")
+
+	run, err := data.sm.Load(ctx, id.RunID)
+	require.NoError(t, err)
+	md := run.Metadata()
+	require.EqualValues(t, 4, len(run.Actions()))
+	require.EqualValues(t, enums.RunStatusCompleted, md.Status)
+	require.EqualValues(t, 0, md.Pending)
+}
+
+func TestServiceInvokeFunction(t *testing.T) {
+	ctx := context.Background()
+
+	child := genF
+	child.ID = "child"
+	child.Name = "child"
+
+	tests := []struct {
+		name       string
+		functionID string
+		expected   map[string]any
+	}{
+		{
+			name:       "it resumes with the invoked run's output",
+			functionID: "child",
+			expected:   map[string]any{"data": map[string]any{"sum": 3}},
+		},
+		{
+			name:       "it resumes with an error for unknown functions",
+			functionID: "missing",
+			expected:   map[string]any{"error": "function not found: missing"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := prepare(ctx, t, genF)
+			err := data.al.(*inmemorydatastore.MemoryExecutionLoader).SetFunctions(ctx, []*function.Function{&genF, &child})
+			require.NoError(t, err)
+
+			var (
+				invocations int32
+				lock        sync.Mutex
+				payload     map[string]any
+				// resumed stores the step outputs available when the invoking
+				// function is resumed.
+				resumed map[string]any
+			)
+
+			data.c.Execution.Drivers["mock"] = &mockdriver.Config{
+				DynamicResponses: func(ctx context.Context, run state.State, av inngest.ActionVersion, s inngest.Step) map[string]state.DriverResponse {
+					if run.Event()["name"] == runner.InvokeEventName {
+						lock.Lock()
+						payload, _ = run.Event()["data"].(map[string]any)
+						lock.Unlock()
+						return map[string]state.DriverResponse{
+							"step": {Output: map[string]any{"sum": 3}},
+						}
+					}
+
+					switch atomic.AddInt32(&invocations, 1) {
+					case 1:
+						return map[string]state.DriverResponse{
+							"step": {
+								Generator: []*state.GeneratorOpcode{
+									{
+										Op: enums.OpcodeInvokeFunction,
+										ID: "invoke",
+										Opts: map[string]any{
+											"function_id": test.functionID,
+											"payload":     map[string]any{"a": 1, "b": 2},
+										},
+									},
+								},
+							},
+						}
+					default:
+						lock.Lock()
+						resumed = run.Actions()
+						lock.Unlock()
+						return map[string]state.DriverResponse{
+							"step": {},
+						}
+					}
 				},
-			}).Map(),
+			}
+
+			svc := NewService(
+				*data.c,
+				WithExecutionLoader(data.al),
+				WithQueue(data.q),
+				WithState(data.sm),
+			)
+			go func() {
+				err := service.Start(ctx, svc)
+				require.NoError(t, err)
+			}()
+
+			id := state.Identifier{
+				WorkflowID: data.w.UUID,
+				RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+			}
+			_, err = data.sm.New(ctx, state.Input{
+				Workflow:   data.w,
+				Identifier: id,
+				EventData:  (event.Event{Name: "test-evt"}).Map(),
+			})
+			require.NoError(t, err)
+
+			err = data.q.Enqueue(ctx, queue.Item{
+				Kind:       queue.KindEdge,
+				Identifier: id,
+				Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
+			}, time.Now())
+			require.NoError(t, err)
+
+			// Wait for the invoking function to resume, then for any duplicate
+			// resumes.
+			require.Eventually(t, func() bool {
+				return atomic.LoadInt32(&invocations) >= 2
+			}, 10*buffer, 10*time.Millisecond)
+			<-time.After(buffer)
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			require.EqualValues(t, 2, atomic.LoadInt32(&invocations), "the invoking function should be resumed once")
+			require.Equal(t, test.expected, resumed["invoke"])
+			if test.functionID == "child" {
+				require.EqualValues(t, map[string]any{"a": float64(1), "b": float64(2)}, payload)
+			}
+
+			run, err := data.sm.Load(ctx, id.RunID)
+			require.NoError(t, err)
+			require.EqualValues(t, enums.RunStatusCompleted, run.Metadata().Status)
+			require.EqualValues(t, 0, run.Metadata().Pending)
 		})
+	}
+}
+
+func TestServiceInvokeFunctionCancelled(t *testing.T) {
+	ctx := context.Background()
+
+	child := genF
+	child.ID = "child"
+	child.Name = "child"
+
+	data := prepare(ctx, t, genF)
+	err := data.al.(*inmemorydatastore.MemoryExecutionLoader).SetFunctions(ctx, []*function.Function{&genF, &child})
+	require.NoError(t, err)
+
+	var (
+		invocations int32
+		lock        sync.Mutex
+		resumed     map[string]any
+	)
+
+	data.c.Execution.Drivers["mock"] = &mockdriver.Config{
+		DynamicResponses: func(ctx context.Context, run state.State, av inngest.ActionVersion, s inngest.Step) map[string]state.DriverResponse {
+			if run.Event()["name"] == runner.InvokeEventName {
+				// The invoked run sleeps until it's cancelled.
+				return map[string]state.DriverResponse{
+					"step": {
+						Generator: []*state.GeneratorOpcode{
+							{Op: enums.OpcodeSleep, ID: "sleep", Name: "1h"},
+						},
+					},
+				}
+			}
+
+			switch atomic.AddInt32(&invocations, 1) {
+			case 1:
+				return map[string]state.DriverResponse{
+					"step": {
+						Generator: []*state.GeneratorOpcode{
+							{
+								Op:   enums.OpcodeInvokeFunction,
+								ID:   "invoke",
+								Opts: map[string]any{"function_id": "child"},
+							},
+						},
+					},
+				}
+			default:
+				lock.Lock()
+				resumed = run.Actions()
+				lock.Unlock()
+				return map[string]state.DriverResponse{
+					"step": {},
+				}
+			}
+		},
+	}
+
+	svc := NewService(
+		*data.c,
+		WithExecutionLoader(data.al),
+		WithQueue(data.q),
+		WithState(data.sm),
+	)
+	go func() {
+		err := service.Start(ctx, svc)
 		require.NoError(t, err)
+	}()
+
+	id := state.Identifier{
+		WorkflowID: data.w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err = data.sm.New(ctx, state.Input{
+		Workflow:   data.w,
+		Identifier: id,
+		EventData:  (event.Event{Name: "test-evt"}).Map(),
 	})
+	require.NoError(t, err)
 
-	t.Run("Running the executor processes a generator step correctly", func(t *testing.T) {
-		err := data.q.Enqueue(ctx, queue.Item{
-			Kind:       queue.KindEdge,
-			Identifier: id,
-			Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
-		}, time.Now())
-		require.NoError(t, err)
+	err = data.q.Enqueue(ctx, queue.Item{
+		Kind:       queue.KindEdge,
+		Identifier: id,
+		Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
+	}, time.Now())
+	require.NoError(t, err)
 
-		<-time.After(time.Until(backoff.LinearJitterBackoff(1)) + (2 * time.Second))
+	require.Eventually(t, func() bool {
+		children, err := data.sm.(state.RunLister).RunningRuns(ctx, function.DeterministicUUID(child), time.Now().Add(-time.Minute), time.Now())
+		return err == nil && len(children) > 0
+	}, 10*buffer, 10*time.Millisecond)
+	<-time.After(buffer)
 
-		run, err := data.sm.Load(ctx, id.RunID)
+	children, err := data.sm.(state.RunLister).RunningRuns(ctx, function.DeterministicUUID(child), time.Now().Add(-time.Minute), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(children))
+
+	// Cancelling the invoked run outside of the executor resumes the invoking run.
+	err = runner.CancelRun(ctx, data.sm, data.q, children[0].RunID)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&invocations) >= 2
+	}, 10*buffer, 10*time.Millisecond)
+	<-time.After(buffer)
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.EqualValues(t, 2, atomic.LoadInt32(&invocations), "the invoking function should be resumed once")
+	require.Equal(t, map[string]any{"error": "the invoked function run was cancelled"}, resumed["invoke"])
+}
+
+func TestInvokeFunctionRetry(t *testing.T) {
+	ctx := context.Background()
+
+	child := genF
+	child.ID = "child"
+	child.Name = "child"
+
+	data := prepare(ctx, t, genF)
+	err := data.al.(*inmemorydatastore.MemoryExecutionLoader).SetFunctions(ctx, []*function.Function{&genF, &child})
+	require.NoError(t, err)
+
+	s := &svc{
+		config: *data.c,
+		state:  data.sm,
+		queue:  data.q,
+		data:   data.al,
+	}
+
+	id := state.Identifier{
+		WorkflowID: data.w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err = data.sm.New(ctx, state.Input{
+		Workflow:   data.w,
+		Identifier: id,
+		EventData:  (event.Event{Name: "test-evt"}).Map(),
+	})
+	require.NoError(t, err)
+
+	gen := &state.GeneratorOpcode{
+		Op:   enums.OpcodeInvokeFunction,
+		ID:   "invoke",
+		Opts: map[string]any{"function_id": "child"},
+	}
+	edge := queue.PayloadEdge{Edge: inngest.Edge{Outgoing: inngest.TriggerName, Incoming: "step"}}
+	item := queue.Item{Kind: queue.KindEdge, Identifier: id, Payload: edge}
+
+	// Retrying the step neither schedules its pause nor invokes the function again.
+	for i := 0; i < 2; i++ {
+		err = s.invokeFunction(ctx, item, edge, gen)
 		require.NoError(t, err)
+	}
+	require.Equal(t, 1, countScheduled(ctx, t, data.sm, id, "step"))
 
-		md := run.Metadata()
+	children, err := data.sm.(state.RunLister).RunningRuns(ctx, function.DeterministicUUID(child), time.Now().Add(-time.Minute), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(children))
+}
 
-		// There should be an entry after a retry.
-		require.EqualValues(t, 1, len(run.Actions()))
-		require.EqualValues(t, enums.RunStatusCompleted, md.Status)
-		require.EqualValues(t, 0, md.Pending)
+func TestInvokeResult(t *testing.T) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+
+	id := state.Identifier{
+		WorkflowID: uuid.New(),
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err := sm.New(ctx, state.Input{Identifier: id})
+	require.NoError(t, err)
+
+	// "a" errors and is retried, while "b" fails the run.
+	_, err = sm.SaveResponse(ctx, id, state.DriverResponse{
+		Step: inngest.Step{ID: "a"},
+		Err:  fmt.Errorf("retrying"),
+	}, 0)
+	require.NoError(t, err)
+	failed := state.DriverResponse{
+		Step: inngest.Step{ID: "b"},
+		Err:  fmt.Errorf("failed"),
+	}
+	failed.SetFinal()
+	_, err = sm.SaveResponse(ctx, id, failed, 0)
+	require.NoError(t, err)
+
+	run, err := sm.Load(ctx, id.RunID)
+	require.NoError(t, err)
+	history, err := sm.History(ctx, id.RunID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"error": "failed"}, invokeResult(run, history))
+}
+
+func TestServiceSendEvent(t *testing.T) {
+	ctx := context.Background()
+	data := prepare(ctx, t, genF)
+
+	var (
+		invocations int32
+		lock        sync.Mutex
+		// resumed stores the step outputs available when the function is
+		// re-invoked after sending events.
+		resumed map[string]any
+	)
+
+	data.c.Execution.Drivers["mock"] = &mockdriver.Config{
+		DynamicResponses: func(ctx context.Context, run state.State, av inngest.ActionVersion, s inngest.Step) map[string]state.DriverResponse {
+			switch atomic.AddInt32(&invocations, 1) {
+			case 1:
+				return map[string]state.DriverResponse{
+					"step": {
+						Generator: []*state.GeneratorOpcode{
+							{
+								Op: enums.OpcodeSendEvent,
+								ID: "send",
+								Opts: map[string]any{
+									"events": []map[string]any{
+										{"name": "test/a", "data": map[string]any{"n": 1}},
+										{"name": "test/b"},
+									},
+								},
+							},
+						},
+					},
+				}
+			default:
+				lock.Lock()
+				resumed = run.Actions()
+				lock.Unlock()
+				return map[string]state.DriverResponse{
+					"step": {},
+				}
+			}
+		},
+	}
+
+	pub := &mockPublisher{}
+	svc := NewService(
+		*data.c,
+		WithExecutionLoader(data.al),
+		WithQueue(data.q),
+		WithState(data.sm),
+		WithPublisher(pub),
+	)
+	go func() {
+		err := service.Start(ctx, svc)
+		require.NoError(t, err)
+	}()
+
+	id := state.Identifier{
+		WorkflowID: data.w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err := data.sm.New(ctx, state.Input{
+		Workflow:   data.w,
+		Identifier: id,
+		EventData:  (event.Event{Name: "test-evt"}).Map(),
+	})
+	require.NoError(t, err)
+
+	err = data.q.Enqueue(ctx, queue.Item{
+		Kind:       queue.KindEdge,
+		Identifier: id,
+		Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
+	}, time.Now())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&invocations) >= 2
+	}, 10*buffer, 10*time.Millisecond)
+	<-time.After(buffer)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	ids := []string{stepEventID(id, "send", 0), stepEventID(id, "send", 1)}
+	require.EqualValues(t, 2, atomic.LoadInt32(&invocations))
+	require.Equal(t, map[string]any{"ids": ids}, resumed["send"])
+
+	sent := pub.Events()
+	require.Equal(t, 2, len(sent))
+	require.Equal(t, "test/a", sent[0].Name)
+	require.Equal(t, ids[0], sent[0].ID)
+	require.EqualValues(t, map[string]any{"n": float64(1)}, sent[0].Data)
+	require.Equal(t, "test/b", sent[1].Name)
+	require.Equal(t, ids[1], sent[1].ID)
+
+	run, err := data.sm.Load(ctx, id.RunID)
+	require.NoError(t, err)
+	require.EqualValues(t, enums.RunStatusCompleted, run.Metadata().Status)
+	require.EqualValues(t, 0, run.Metadata().Pending)
+}
+
+func TestSendEventsRetry(t *testing.T) {
+	ctx := context.Background()
+	data := prepare(ctx, t, genF)
+
+	// Fail publishing the second event once.
+	pub := &mockPublisher{fail: 2}
+	s := &svc{
+		config:    *data.c,
+		state:     data.sm,
+		publisher: pub,
+	}
+
+	id := state.Identifier{
+		WorkflowID: data.w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err := data.sm.New(ctx, state.Input{
+		Workflow:   data.w,
+		Identifier: id,
+		EventData:  (event.Event{Name: "test-evt"}).Map(),
+	})
+	require.NoError(t, err)
+
+	gen := &state.GeneratorOpcode{
+		Op: enums.OpcodeSendEvent,
+		ID: "send",
+		Opts: map[string]any{
+			"events": []map[string]any{{"name": "test/a"}, {"name": "test/b"}},
+		},
+	}
+	item := queue.Item{Kind: queue.KindEdge, Identifier: id}
+
+	err = s.sendEvents(ctx, item, gen)
+	require.Error(t, err)
+
+	// Retrying sends identical events with the same IDs.
+	err = s.sendEvents(ctx, item, gen)
+	require.NoError(t, err)
+	ids := []string{stepEventID(id, "send", 0), stepEventID(id, "send", 1)}
+	sent := pub.Events()
+	require.Equal(t, 3, len(sent))
+	require.Equal(t, ids[0], sent[0].ID)
+	require.Equal(t, ids[0], sent[1].ID)
+	require.Equal(t, ids[1], sent[2].ID)
+	require.Equal(t, sent[0], sent[1])
+	require.Equal(t, int64(id.RunID.Time()), sent[0].Timestamp)
+
+	run, err := data.sm.Load(ctx, id.RunID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"ids": ids}, run.Actions()["send"])
+
+	// Once the step's output is saved, events are never sent again.
+	err = s.sendEvents(ctx, item, gen)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pub.Events()))
+}
+
+// mockPublisher records events published to the event stream.
+type mockPublisher struct {
+	lock   sync.Mutex
+	calls  int
+	events []event.Event
+	// fail is the 1-indexed publish call which errors, if non-zero.
+	fail int
+}
+
+func (m *mockPublisher) Publish(ctx context.Context, topic string, msg pubsub.Message) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.calls++
+	if m.calls == m.fail {
+		return fmt.Errorf("publish failed")
+	}
+	evt, err := event.NewEvent(msg.Data)
+	if err != nil {
+		return err
+	}
+	m.events = append(m.events, *evt)
+	return nil
+}
+
+func (m *mockPublisher) Events() []event.Event {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.events
+}
+
+// joinStep returns a mock step which runs after the given edges.
+func joinStep(id string, after ...function.After) function.Step {
+	return function.Step{
+		ID: id,
+		Runtime: &inngest.RuntimeWrapper{
+			Runtime: &mockdriver.Mock{},
+		},
+		After: after,
+	}
+}
+
+// joinF runs "d" after "a", "b" and "c" have finished or been skipped.
+var joinF = function.Function{
+	ID:   "join",
+	Name: "join",
+	Triggers: []function.Trigger{
+		{EventTrigger: &function.EventTrigger{Event: "test-evt"}},
+	},
+	Steps: map[string]function.Step{
+		"a": joinStep("a"),
+		"b": joinStep("b", function.After{Step: inngest.TriggerName, If: "event.data.skip != true"}),
+		"c": joinStep("c"),
+		"d": joinStep("d", function.After{Steps: []string{"a", "b", "c"}}),
+	},
+}
+
+func TestServiceJoin(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		data     map[string]any
+		expected []string
+	}{
+		{
+			name:     "it runs joins once after every step finishes",
+			data:     map[string]any{},
+			expected: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:     "it runs joins after skipped steps",
+			data:     map[string]any{"skip": true},
+			expected: []string{"a", "c", "d"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := prepare(ctx, t, joinF)
+
+			var (
+				lock sync.Mutex
+				ran  []string
+			)
+			data.c.Execution.Drivers["mock"] = &mockdriver.Config{
+				DynamicResponses: func(ctx context.Context, run state.State, av inngest.ActionVersion, s inngest.Step) map[string]state.DriverResponse {
+					lock.Lock()
+					ran = append(ran, s.ID)
+					lock.Unlock()
+					return map[string]state.DriverResponse{
+						s.ID: {Output: map[string]any{"id": s.ID}},
+					}
+				},
+			}
+
+			svc := NewService(
+				*data.c,
+				WithExecutionLoader(data.al),
+				WithQueue(data.q),
+				WithState(data.sm),
+			)
+			go func() {
+				err := service.Start(ctx, svc)
+				require.NoError(t, err)
+			}()
+
+			id := state.Identifier{
+				WorkflowID: data.w.UUID,
+				RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+			}
+			_, err := data.sm.New(ctx, state.Input{
+				Workflow:   data.w,
+				Identifier: id,
+				EventData:  (event.Event{Name: "test-evt", Data: test.data}).Map(),
+			})
+			require.NoError(t, err)
+
+			err = data.q.Enqueue(ctx, queue.Item{
+				Kind:       queue.KindEdge,
+				Identifier: id,
+				Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
+			}, time.Now())
+			require.NoError(t, err)
+
+			// Wait for the run to finish, then for any duplicate steps.
+			require.Eventually(t, func() bool {
+				run, err := data.sm.Load(ctx, id.RunID)
+				return err == nil && run.Metadata().Status == enums.RunStatusCompleted
+			}, 10*buffer, 10*time.Millisecond)
+			<-time.After(buffer)
+
+			lock.Lock()
+			defer lock.Unlock()
+			require.ElementsMatch(t, test.expected, ran, "the join must run exactly once")
+			require.Equal(t, "d", ran[len(ran)-1], "the join must run after every step")
+
+			require.Equal(t, 1, countScheduled(ctx, t, data.sm, id, "d"), "the join must be scheduled exactly once")
+
+			run, err := data.sm.Load(ctx, id.RunID)
+			require.NoError(t, err)
+			require.EqualValues(t, enums.RunStatusCompleted, run.Metadata().Status)
+			require.EqualValues(t, 0, run.Metadata().Pending)
+		})
+	}
+}
+
+// TestServiceJoinRedelivery ensures that join steps which report more than once,
+// eg. as their queue items are redelivered, enqueue the join once.
+func TestServiceJoinRedelivery(t *testing.T) {
+	ctx := context.Background()
+	data := prepare(ctx, t, joinF)
+	data.c.Execution.Drivers["mock"] = &mockdriver.Config{}
+	q := &countingQueue{Queue: data.q}
+	data.q = q
+	s, id := newJoinRun(ctx, t, data, "a", "b", "c")
+
+	// Step "c" is redelivered after the join completes.
+	for _, step := range []string{"a", "b", "c", "c"} {
+		err := s.handleQueueItem(ctx, queue.Item{
+			Kind:       queue.KindEdge,
+			Identifier: id,
+			Payload: queue.PayloadEdge{
+				Edge: inngest.Edge{Outgoing: inngest.TriggerName, Incoming: step},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 1, q.count("d"), "the join must be enqueued once")
+}
+
+func TestServiceJoinPauseTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	// "b" waits for an event which never arrives, and is skipped when its
+	// pause expires.
+	f := joinF
+	f.Steps = map[string]function.Step{
+		"a": joinStep("a"),
+		"b": joinStep("b", function.After{
+			Step: inngest.TriggerName,
+			Async: &inngest.AsyncEdgeMetadata{
+				TTL:   "1h",
+				Event: "test/never",
+			},
+		}),
+		"c": joinStep("c"),
+		"d": joinStep("d", function.After{Steps: []string{"a", "b", "c"}}),
+	}
+	data := prepare(ctx, t, f)
+	data.c.Execution.Drivers["mock"] = &mockdriver.Config{}
+	s, id := newJoinRun(ctx, t, data, "a", "c")
+
+	pause := state.Pause{
+		ID:         uuid.New(),
+		Identifier: id,
+		Outgoing:   inngest.TriggerName,
+		Incoming:   "b",
+		Expires:    state.Time(time.Now().Add(time.Hour)),
+	}
+	require.NoError(t, data.sm.SavePause(ctx, pause))
+	err := s.handlePauseTimeout(ctx, queue.Item{
+		Kind:       queue.KindPause,
+		Identifier: id,
+		Payload:    queue.PayloadPauseTimeout{PauseID: pause.ID},
+	})
+	require.NoError(t, err)
+
+	for _, step := range []string{"a", "c"} {
+		err := s.handleQueueItem(ctx, queue.Item{
+			Kind:       queue.KindEdge,
+			Identifier: id,
+			Payload: queue.PayloadEdge{
+				Edge: inngest.Edge{Outgoing: inngest.TriggerName, Incoming: step},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 1, countScheduled(ctx, t, data.sm, id, "d"), "the join must run after the expired step")
+}
+
+// newJoinRun creates a run of the prepared function in which the given steps
+// have already finished.
+func newJoinRun(ctx context.Context, t *testing.T, data prepared, finished ...string) (*svc, state.Identifier) {
+	t.Helper()
+	s := NewService(
+		*data.c,
+		WithExecutionLoader(data.al),
+		WithQueue(data.q),
+		WithState(data.sm),
+	).(*svc)
+	require.NoError(t, s.Pre(ctx))
+
+	id := state.Identifier{
+		WorkflowID: data.w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err := data.sm.New(ctx, state.Input{
+		Workflow:   data.w,
+		Identifier: id,
+		EventData:  (event.Event{Name: "test-evt"}).Map(),
+	})
+	require.NoError(t, err)
+
+	for _, step := range finished {
+		_, err := data.sm.SaveResponse(ctx, id, state.DriverResponse{
+			Step:   inngest.Step{ID: step},
+			Output: map[string]any{"id": step},
+		}, 0)
+		require.NoError(t, err)
+	}
+	return s, id
+}
+
+// TestServicePlannedStepRedelivery ensures that planned steps which report more
+// than once, eg. as their queue items are redelivered, re-invoke the function once.
+func TestServicePlannedStepRedelivery(t *testing.T) {
+	ctx := context.Background()
+	data := prepare(ctx, t, genF)
+	data.c.Execution.Drivers["mock"] = &mockdriver.Config{}
+	q := &countingQueue{Queue: data.q}
+
+	s := NewService(
+		*data.c,
+		WithExecutionLoader(data.al),
+		WithQueue(q),
+		WithState(data.sm),
+	).(*svc)
+	require.NoError(t, s.Pre(ctx))
+
+	id := state.Identifier{
+		WorkflowID: data.w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+	}
+	_, err := data.sm.New(ctx, state.Input{
+		Workflow:   data.w,
+		Identifier: id,
+		EventData:  (event.Event{Name: "test-evt"}).Map(),
+	})
+	require.NoError(t, err)
+
+	// Both planned steps have run, so executing them returns their saved output.
+	group := &queue.PlannedGroup{ID: "group", Steps: []string{"a", "b"}}
+	for _, step := range group.Steps {
+		_, err := data.sm.SaveResponse(ctx, id, state.DriverResponse{
+			Step:   inngest.Step{ID: step},
+			Output: map[string]any{"ok": true},
+		}, 0)
+		require.NoError(t, err)
+	}
+
+	for _, step := range []string{"a", "b", "b", "a"} {
+		err := s.handleQueueItem(ctx, queue.Item{
+			Kind:       queue.KindEdge,
+			Identifier: id,
+			Payload: queue.PayloadEdge{
+				Edge: inngest.Edge{
+					Outgoing:              inngest.TriggerName,
+					Incoming:              "step",
+					IncomingGeneratorStep: step,
+				},
+				Group: group,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 1, q.count("step"), "the function must be re-invoked once")
+}
+
+// countingQueue counts the edge items enqueued for each step.
+type countingQueue struct {
+	queue.Queue
+
+	lock     sync.Mutex
+	enqueued map[string]int
+}
+
+func (c *countingQueue) Enqueue(ctx context.Context, item queue.Item, at time.Time) error {
+	err := c.Queue.Enqueue(ctx, item, at)
+	if edge, ok := item.Payload.(queue.PayloadEdge); ok && err == nil {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if c.enqueued == nil {
+			c.enqueued = map[string]int{}
+		}
+		c.enqueued[edge.Edge.Incoming]++
+	}
+	return err
+}
+
+func (c *countingQueue) count(stepID string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.enqueued[stepID]
+}
+
+// countScheduled returns the number of times the given step was scheduled.
+func countScheduled(ctx context.Context, t *testing.T, sm state.Manager, id state.Identifier, stepID string) int {
+	t.Helper()
+	history, err := sm.History(ctx, id.RunID)
+	require.NoError(t, err)
+	scheduled := 0
+	for _, h := range history {
+		if h.Type == enums.HistoryTypeStepScheduled && h.Data.(state.HistoryStep).ID == stepID {
+			scheduled++
+		}
+	}
+	return scheduled
+}
+
+func TestServiceRetry(t *testing.T) {
+	ctx := context.Background()
+	data := prepare(ctx, t, genF)
+	require.NotNil(t, data)
+
+	var counter int32
+
+	// Ensure our step returns a generator response.
+	data.c.Execution.Drivers["mock"] = &mockdriver.Config{
+		DynamicResponses: func(ctx context.Context, run state.State, av inngest.ActionVersion, s inngest.Step) map[string]state.DriverResponse {
+			switch atomic.AddInt32(&counter, 1) {
+			case 1:
+				// Error first.
+				return map[string]state.DriverResponse{
+					"step": {
+						Err: fmt.Errorf("failure"),
+					},
+				}
+			default:
+				return map[string]state.DriverResponse{
+					"step": {},
+				}
+
+			}
+
+		},
+	}
+
+	svc := NewService(
+		*data.c,
+		WithExecutionLoader(data.al),
+		WithQueue(data.q),
+		WithState(data.sm),
+	)
+	go func() {
+		err := service.Start(ctx, svc)
+		require.NoError(t, err)
+	}()
+
+	var id state.Identifier
+	t.Run("Create a new run", func(t *testing.T) {
+		// Create a new run.
+		id = state.Identifier{
+			WorkflowID: data.w.UUID,
+			RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+		}
+		_, err := data.sm.New(ctx, state.Input{
+			Workflow:   data.w,
+			Identifier: id,
+			EventData: (event.Event{
+				Name: "test",
+				Data: map[string]interface{}{
+					"data": "ya",
+				},
+			}).Map(),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Running the executor processes a generator step correctly", func(t *testing.T) {
+		err := data.q.Enqueue(ctx, queue.Item{
+			Kind:       queue.KindEdge,
+			Identifier: id,
+			Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
+		}, time.Now())
+		require.NoError(t, err)
+
+		<-time.After(time.Until(backoff.LinearJitterBackoff(1)) + (2 * time.Second))
+
+		run, err := data.sm.Load(ctx, id.RunID)
+		require.NoError(t, err)
+
+		md := run.Metadata()
+
+		// There should be an entry after a retry.
+		require.EqualValues(t, 1, len(run.Actions()))
+		require.EqualValues(t, enums.RunStatusCompleted, md.Status)
+		require.EqualValues(t, 0, md.Pending)
+	})
+}
+
+// TestServiceTimeouts ensures that runs exceeding their function's timeouts are
+// cancelled, recording the timeout within the run's history.
+func TestServiceTimeouts(t *testing.T) {
+	ctx := context.Background()
+
+	f := asyncF
+	runTimeout := "200ms"
+	f.Timeouts = &inngest.Timeouts{Run: &runTimeout}
+	data := prepare(ctx, t, f)
+
+	s := NewService(
+		*data.c,
+		WithExecutionLoader(data.al),
+		WithQueue(data.q),
+		WithState(data.sm),
+	)
+
+	go func() {
+		err := service.Start(ctx, s)
+		require.NoError(t, err)
+	}()
+
+	t.Run("It cancels runs exceeding the run timeout", func(t *testing.T) {
+		id, err := runner.Initialize(ctx, f, event.Event{ID: "evt-1", Name: "test-evt"}, data.sm, data.q)
+		require.NoError(t, err)
+
+		// The run waits for events for longer than the run timeout.
+		var last state.History
+		require.Eventually(t, func() bool {
+			history, err := data.sm.History(ctx, id.RunID)
+			if err != nil {
+				return false
+			}
+			for _, h := range history {
+				if h.Type == enums.HistoryTypeFunctionCancelled {
+					last = h
+					return true
+				}
+			}
+			return false
+		}, 10*buffer, 10*time.Millisecond)
+
+		run, err := data.sm.Load(ctx, id.RunID)
+		require.NoError(t, err)
+		require.Equal(t, enums.RunStatusCancelled, run.Metadata().Status)
+		cancelled, ok := last.Data.(state.HistoryFunctionCancelled)
+		require.True(t, ok)
+		require.Equal(t, enums.CancellationTypeTimeout, cancelled.Type)
+		require.Equal(t, queue.PayloadTimeout{Timeout: queue.TimeoutRun, Duration: "200ms"}, cancelled.Data)
+	})
+
+	t.Run("It cancels runs whose first step hasn't started", func(t *testing.T) {
+		item := queue.Item{
+			Kind:    queue.KindTimeout,
+			Payload: queue.PayloadTimeout{Timeout: queue.TimeoutStart, Duration: "10m"},
+		}
+
+		// The run's first step isn't enqueued, so the run never starts.
+		item.Identifier = state.Identifier{
+			WorkflowID: data.w.UUID,
+			RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+		}
+		_, err := data.sm.New(ctx, state.Input{Workflow: data.w, Identifier: item.Identifier})
+		require.NoError(t, err)
+
+		err = s.(*svc).handleTimeout(ctx, item)
+		require.NoError(t, err)
+		run, err := data.sm.Load(ctx, item.Identifier.RunID)
+		require.NoError(t, err)
+		require.Equal(t, enums.RunStatusCancelled, run.Metadata().Status)
+
+		// Runs which have started are unaffected.
+		item.Identifier.RunID = ulid.MustNew(ulid.Now(), rand.Reader)
+		_, err = data.sm.New(ctx, state.Input{Workflow: data.w, Identifier: item.Identifier})
+		require.NoError(t, err)
+		err = data.sm.Started(ctx, item.Identifier, "1", 0)
+		require.NoError(t, err)
+
+		err = s.(*svc).handleTimeout(ctx, item)
+		require.NoError(t, err)
+		run, err = data.sm.Load(ctx, item.Identifier.RunID)
+		require.NoError(t, err)
+		require.Equal(t, enums.RunStatusRunning, run.Metadata().Status)
 	})
 }
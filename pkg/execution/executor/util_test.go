@@ -21,6 +21,7 @@ func TestParseWait(t *testing.T) {
 		map[string]any{
 			"data": time.Now().Format(time.RFC3339),
 		},
+		nil,
 		map[string]any{
 			"step-1": map[string]any{
 				"wait": time.Now().Format(time.RFC3339),
@@ -0,0 +1,88 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	ErrAdminItemNotFound      = fmt.Errorf("queue item not found")
+	ErrAdminPartitionNotFound = fmt.Errorf("queue partition not found")
+	// ErrAdminItemLeased is returned when attempting to modify an item which is
+	// currently leased by a worker.
+	ErrAdminItemLeased = fmt.Errorf("queue item is leased by a worker")
+)
+
+const (
+	LeaseKindSequential = "sequential"
+	LeaseKindPartition  = "partition"
+	LeaseKindItem       = "item"
+)
+
+// Admin allows inspection and modification of the queue.  All methods must be
+// safe to call against a queue which is being processed by live workers.
+type Admin interface {
+	// Partitions returns up to limit partitions, ordered by the time of the
+	// partition's next available item.
+	Partitions(ctx context.Context, limit int64) ([]Partition, error)
+
+	// PartitionItems returns up to limit unleased items for the given function,
+	// ordered by the time each item runs.
+	PartitionItems(ctx context.Context, workflowID uuid.UUID, limit int64) ([]QueuedItem, error)
+
+	// Leases returns all active leases held by workers, including the sequential
+	// scanner's lease and leases for up to limit partitions.
+	Leases(ctx context.Context, limit int64) ([]Lease, error)
+
+	// ReprioritizePartition updates the priority of the given function's partition.
+	// This must return ErrAdminPartitionNotFound if the partition doesn't exist.
+	ReprioritizePartition(ctx context.Context, workflowID uuid.UUID, priority uint) error
+
+	// DeleteItem removes the given item from the queue.  This must return
+	// ErrAdminItemLeased if the item is being processed by a worker.
+	DeleteItem(ctx context.Context, id string) error
+
+	// RequeueItem reschedules the given item to run at the given time.  This must
+	// return ErrAdminItemLeased if the item is being processed by a worker.
+	RequeueItem(ctx context.Context, id string, at time.Time) error
+}
+
+// Partition is a queue of items for a single function.
+type Partition struct {
+	WorkflowID uuid.UUID `json:"wfID"`
+	// Priority is the priority of the partition, where 0 is the highest priority.
+	Priority uint `json:"priority"`
+	// At is the time of the partition's next available item.
+	At time.Time `json:"at"`
+	// Len is the number of items within the partition.
+	Len int64 `json:"len"`
+	// LeaseID is the partition's lease, if the partition is currently leased.
+	LeaseID *ulid.ULID `json:"leaseID,omitempty"`
+}
+
+// QueuedItem is an individual item within a partition.
+type QueuedItem struct {
+	ID         string    `json:"id"`
+	WorkflowID uuid.UUID `json:"wfID"`
+	// At is the time that the item runs.
+	At   time.Time `json:"at"`
+	Item Item      `json:"item"`
+	// LeaseID is the item's lease, if the item is currently being processed.
+	LeaseID *ulid.ULID `json:"leaseID,omitempty"`
+}
+
+// Lease is an active lease held by a worker.
+type Lease struct {
+	// Kind is one of LeaseKindSequential, LeaseKindPartition or LeaseKindItem.
+	Kind string `json:"kind"`
+	// ID is the leased partition's function ID or the leased item's ID, and is
+	// empty for the sequential lease.
+	ID      string    `json:"id,omitempty"`
+	LeaseID ulid.ULID `json:"leaseID"`
+	// Expires is the time that the lease expires, if not extended.
+	Expires time.Time `json:"expires"`
+}
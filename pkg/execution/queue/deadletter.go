@@ -0,0 +1,55 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrDeadLetterNotFound   = fmt.Errorf("dead letter not found")
+	ErrDeadLetterUnreadable = fmt.Errorf("dead letter item cannot be read")
+)
+
+// DeadLetterQueue stores queue items which permanently failed, allowing them to be
+// inspected, requeued or purged.
+type DeadLetterQueue interface {
+	// DeadLetters returns up to limit dead letters, most recently failed first.
+	DeadLetters(ctx context.Context, limit int64) ([]DeadLetter, error)
+
+	// DeadLetter returns a single dead letter by its ID.  This must return
+	// ErrDeadLetterNotFound if the dead letter doesn't exist.
+	DeadLetter(ctx context.Context, id string) (*DeadLetter, error)
+
+	// RequeueDeadLetter enqueues the dead letter's item to run immediately with
+	// its attempts reset, then removes the dead letter.  This must return
+	// ErrDeadLetterUnreadable if the dead letter's item could not be read.
+	RequeueDeadLetter(ctx context.Context, id string) error
+
+	// PurgeDeadLetters removes the given dead letters, or every dead letter if no
+	// IDs are given, returning the number of dead letters removed.
+	PurgeDeadLetters(ctx context.Context, ids ...string) (int64, error)
+}
+
+// DeadLetter is a queue item which permanently failed.
+type DeadLetter struct {
+	// ID is the ID of the failed queue item.
+	ID string `json:"id"`
+	// WorkflowID is the ID of the function that the item belongs to.
+	WorkflowID uuid.UUID `json:"wfID"`
+	// Item is the failed item.  This is nil if the stored item could not be
+	// unmarshalled, in which case Raw contains the item as stored in the queue.
+	Item *Item `json:"item,omitempty"`
+	// Raw contains the stored item if the item could not be unmarshalled.
+	Raw string `json:"raw,omitempty"`
+	// Error is the error which permanently failed the item.
+	Error string `json:"error"`
+	// Attempt is the zero index attempt which failed.
+	Attempt int `json:"atts"`
+	// At is the time that the failed attempt was scheduled for.
+	At time.Time `json:"at"`
+	// FailedAt is the time that the item was moved to the dead-letter queue.
+	FailedAt time.Time `json:"failedAt"`
+}
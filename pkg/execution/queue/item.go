@@ -3,16 +3,31 @@ package queue
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/backoff"
 	"github.com/inngest/inngest/pkg/consts"
 	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
 )
 
 const (
-	KindEdge  = "edge"
-	KindPause = "pause"
+	KindEdge     = "edge"
+	KindPause    = "pause"
+	KindDebounce = "debounce"
+	KindTimeout  = "timeout"
+	// KindInvokeFinished resumes the run which invoked the item's run, once
+	// the item's run has finished.
+	KindInvokeFinished = "invoke-finished"
+)
+
+const (
+	// TimeoutRun is the timeout for a run's total duration.
+	TimeoutRun = "run"
+	// TimeoutStart is the timeout for a run's first step to start.
+	TimeoutStart = "start"
 )
 
 // Item represents an item stored within a queue.
@@ -38,6 +53,16 @@ type Item struct {
 	// Payload stores item-specific data for use when processing the item.  For example,
 	// this may contain the function's edge for running a step.
 	Payload any `json:"payload,omitempty"`
+	// Throttle optionally limits how many items with the same throttle key can
+	// start within a period.  This is applied when the item is enqueued, and
+	// delays the item if the limit has been reached.
+	Throttle *Throttle `json:"throttle,omitempty"`
+	// Backoff is the backoff policy used when retrying the item.  If nil, the
+	// item is retried using backoff.LinearJitterBackoff.
+	Backoff *backoff.Policy `json:"backoff,omitempty"`
+	// MaxDuration is the maximum time that each attempt can run for.  If
+	// zero, the queue's default max duration is used.
+	MaxDuration time.Duration `json:"maxDur,omitempty"`
 }
 
 func (i Item) GetMaxAttempts() int {
@@ -47,6 +72,16 @@ func (i Item) GetMaxAttempts() int {
 	return *i.MaxAttempts
 }
 
+// RetryAt returns the time at which the item's current attempt should run, using
+// the item's backoff policy.  This must be called after incrementing the attempt
+// when retrying an item.
+func (i Item) RetryAt() time.Time {
+	if i.Backoff == nil {
+		return backoff.LinearJitterBackoff(i.Attempt)
+	}
+	return i.Backoff.At(i.Attempt)
+}
+
 func (i *Item) UnmarshalJSON(b []byte) error {
 	type kind struct {
 		Kind        string           `json:"kind"`
@@ -55,6 +90,9 @@ func (i *Item) UnmarshalJSON(b []byte) error {
 		MaxAttempts *int             `json:"maxAtts,omitempty"`
 		Payload     json.RawMessage  `json:"payload"`
 		WorkspaceID uuid.UUID        `json:"wsID"`
+		Throttle    *Throttle        `json:"throttle,omitempty"`
+		Backoff     *backoff.Policy  `json:"backoff,omitempty"`
+		MaxDuration time.Duration    `json:"maxDur,omitempty"`
 	}
 	temp := &kind{}
 	err := json.Unmarshal(b, temp)
@@ -67,6 +105,9 @@ func (i *Item) UnmarshalJSON(b []byte) error {
 	i.Attempt = temp.Attempt
 	i.MaxAttempts = temp.MaxAttempts
 	i.WorkspaceID = temp.WorkspaceID
+	i.Throttle = temp.Throttle
+	i.Backoff = temp.Backoff
+	i.MaxDuration = temp.MaxDuration
 	// Save this for custom unmarshalling of other jobs.  This is overwritten
 	// for known queue kinds.
 	if len(temp.Payload) > 0 {
@@ -92,10 +133,41 @@ func (i *Item) UnmarshalJSON(b []byte) error {
 			return err
 		}
 		i.Payload = *p
+	case KindDebounce:
+		if len(temp.Payload) == 0 {
+			return nil
+		}
+		p := &PayloadDebounce{}
+		if err := json.Unmarshal(temp.Payload, p); err != nil {
+			return err
+		}
+		i.Payload = *p
+	case KindTimeout:
+		if len(temp.Payload) == 0 {
+			return nil
+		}
+		p := &PayloadTimeout{}
+		if err := json.Unmarshal(temp.Payload, p); err != nil {
+			return err
+		}
+		i.Payload = *p
 	}
 	return nil
 }
 
+// Throttle represents throttling for new function runs.  At most Limit items
+// with the same Key start within each Period;  any further items are delayed
+// until capacity is available.
+type Throttle struct {
+	// Key is the throttling key, unique to each function and evaluated
+	// throttle key expression.
+	Key string `json:"k"`
+	// Limit is the maximum number of items which can start within Period.
+	Limit int `json:"l"`
+	// Period is the throttling period, in seconds.
+	Period int `json:"p"`
+}
+
 // GetEdge returns the edge from the enqueued item, if the payload is of type PayloadEdge.
 func GetEdge(i Item) (*inngest.Edge, error) {
 	switch v := i.Payload.(type) {
@@ -106,10 +178,49 @@ func GetEdge(i Item) (*inngest.Edge, error) {
 	}
 }
 
+// EdgeBackoff returns the backoff policy for retrying the edge's incoming step,
+// or nil if the step uses the default backoff.
+func EdgeBackoff(w inngest.Workflow, edge inngest.Edge) *backoff.Policy {
+	step := w.Step(edge.Incoming)
+	if step == nil {
+		return nil
+	}
+	// Backoffs are validated when functions are deployed;  fall back to the
+	// default backoff if the policy is invalid.
+	p, _ := step.Backoff()
+	return p
+}
+
+// EdgeMaxDuration returns the maximum duration of each attempt of the edge's
+// incoming step, or zero if the step uses the queue's default max duration.
+func EdgeMaxDuration(w inngest.Workflow, edge inngest.Edge) time.Duration {
+	step := w.Step(edge.Incoming)
+	if step == nil {
+		return 0
+	}
+	// Timeouts are validated when functions are deployed;  fall back to the
+	// queue's default if the timeout is invalid.
+	dur, _ := step.TimeoutDuration()
+	return dur
+}
+
 // PayloadEdge is the payload stored when enqueueing an edge traversal to execute
 // the incoming step of the edge.
 type PayloadEdge struct {
 	Edge inngest.Edge `json:"edge"`
+	// Group is the group of generator steps planned in parallel, set when the
+	// edge runs one of the group's planned steps.
+	Group *PlannedGroup `json:"group,omitempty"`
+}
+
+// PlannedGroup is a group of generator steps which an SDK-based function planned
+// to run in parallel.  The function is invoked again once every step within the
+// group has reported.
+type PlannedGroup struct {
+	// ID uniquely identifies the group within the function run.
+	ID string `json:"id"`
+	// Steps lists the IDs of each planned step within the group.
+	Steps []string `json:"steps"`
 }
 
 // PayloadPauseTimeout is the payload stored when enqueueing a pause timeout, eg.
@@ -121,3 +232,22 @@ type PayloadPauseTimeout struct {
 	PauseID   uuid.UUID `json:"pauseID"`
 	OnTimeout bool      `json:"onTimeout"`
 }
+
+// PayloadDebounce is the payload stored when enqueueing a debounced function run,
+// to start the run after the debounce period if no newer event has been received.
+type PayloadDebounce struct {
+	// Key is the debounce key for the function.
+	Key string `json:"key"`
+	// DebounceID is the ID of the debounce created with this item.  The run
+	// only starts if this is still the latest debounce for the key.
+	DebounceID ulid.ULID `json:"debounceID"`
+}
+
+// PayloadTimeout is the payload stored when enqueueing one of a function's
+// timeouts, to cancel the run if it exceeds the timeout.
+type PayloadTimeout struct {
+	// Timeout is the type of timeout, eg. TimeoutRun or TimeoutStart.
+	Timeout string `json:"timeout"`
+	// Duration is the timeout's configured duration, eg. "2h".
+	Duration string `json:"duration"`
+}
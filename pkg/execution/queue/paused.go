@@ -0,0 +1,22 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FunctionPauser allows an entire function to be paused within the queue.  While
+// a function is paused its items are not processed, though new items can still be
+// enqueued.  Resuming the function processes its items in order.
+type FunctionPauser interface {
+	// PauseFunction stops processing the given function's items until the
+	// function is resumed.
+	PauseFunction(ctx context.Context, workflowID uuid.UUID) error
+
+	// ResumeFunction resumes processing the given function's items.
+	ResumeFunction(ctx context.Context, workflowID uuid.UUID) error
+
+	// PausedFunctions returns the IDs of all paused functions.
+	PausedFunctions(ctx context.Context) ([]uuid.UUID, error)
+}
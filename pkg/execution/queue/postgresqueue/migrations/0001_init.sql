@@ -0,0 +1,56 @@
+-- +goose Up
+
+-- queue_items stores every enqueued item until the item is dequeued.
+CREATE TABLE public.queue_items (
+  id character varying(255) NOT NULL,
+  workflow_id uuid NOT NULL,
+  -- the time that the item becomes available to workers
+  at timestamp with time zone NOT NULL,
+  -- the JSON-encoded queue.Item
+  item text NOT NULL,
+  -- the lease held by the worker processing the item, if any
+  lease_id character(26),
+  leased_until timestamp with time zone,
+  -- the item's concurrency key and limit, null if the item has no limit
+  concurrency_key text,
+  concurrency_limit integer,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX queue_items_partition ON public.queue_items USING btree (workflow_id, at, id);
+CREATE INDEX queue_items_concurrency ON public.queue_items USING btree (concurrency_key, leased_until) WHERE concurrency_key IS NOT NULL;
+
+-- queue_partitions stores a row for each function with enqueued items, used to
+-- fairly select functions to process.
+CREATE TABLE public.queue_partitions (
+  workflow_id uuid NOT NULL,
+  paused boolean NOT NULL DEFAULT false,
+  last_leased_at timestamp with time zone,
+  PRIMARY KEY (workflow_id)
+);
+
+-- queue_idempotency_keys ensures that each job ID is enqueued once.  Keys with a
+-- null expiry belong to items which are still enqueued.
+CREATE TABLE public.queue_idempotency_keys (
+  id character varying(255) NOT NULL,
+  expires_at timestamp with time zone,
+  PRIMARY KEY (id)
+);
+
+-- queue_throttles stores the start time reserved for each throttled item.
+CREATE TABLE public.queue_throttles (
+  key text NOT NULL,
+  item_id character varying(255) NOT NULL,
+  at timestamp with time zone NOT NULL,
+  expires_at timestamp with time zone NOT NULL,
+  PRIMARY KEY (key, item_id)
+);
+
+CREATE INDEX queue_throttles_at ON public.queue_throttles USING btree (key, at);
+
+-- +goose Down
+DROP TABLE public.queue_throttles;
+DROP TABLE public.queue_idempotency_keys;
+DROP TABLE public.queue_partitions;
+DROP TABLE public.queue_items;
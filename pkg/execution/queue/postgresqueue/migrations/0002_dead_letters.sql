@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- queue_dead_letters stores items which permanently failed, until they're
+-- requeued or purged.
+CREATE TABLE public.queue_dead_letters (
+  id character varying(255) NOT NULL,
+  workflow_id uuid NOT NULL,
+  -- the JSON-encoded queue.DeadLetter
+  dead_letter text NOT NULL,
+  failed_at timestamp with time zone NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX queue_dead_letters_failed_at ON public.queue_dead_letters USING btree (failed_at, id);
+
+-- +goose Down
+DROP TABLE public.queue_dead_letters;
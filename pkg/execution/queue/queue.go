@@ -2,9 +2,14 @@ package queue
 
 import (
 	"context"
+	"fmt"
 	"time"
 )
 
+// ErrQueueItemExists is returned when enqueueing an item whose JobID is already
+// enqueued, or was dequeued within the queue's idempotency period.
+var ErrQueueItemExists = fmt.Errorf("queue item already exists")
+
 type Queue interface {
 	Producer
 	Consumer
@@ -48,6 +53,20 @@ type AlwaysRetryableError interface {
 	AlwaysRetryable()
 }
 
+// JobTimeoutError is returned when a job runs for longer than its max duration.
+// The job's context is cancelled, and the job is retried.
+type JobTimeoutError struct {
+	MaxDuration time.Duration
+}
+
+func (e JobTimeoutError) Error() string {
+	return fmt.Sprintf("job exceeded its max duration of %s", e.MaxDuration)
+}
+
+func (e JobTimeoutError) Retryable() bool {
+	return true
+}
+
 // ShouldRetry returns whether we need to retry an error.
 func ShouldRetry(err error, attempt int, max int) bool {
 	if _, ok := err.(AlwaysRetryableError); ok {
@@ -0,0 +1,64 @@
+-- The SQLite queue schema, adapted from the Postgres queue's migrations.  All
+-- times are stored as millisecond epochs.
+
+-- queue_items stores every enqueued item until the item is dequeued.
+CREATE TABLE IF NOT EXISTS queue_items (
+  id text NOT NULL,
+  workflow_id text NOT NULL,
+  -- the time that the item becomes available to workers
+  at integer NOT NULL,
+  -- the JSON-encoded queue.Item
+  item text NOT NULL,
+  -- the lease held by the worker processing the item, if any
+  lease_id text,
+  leased_until integer,
+  -- the item's concurrency key and limit, null if the item has no limit
+  concurrency_key text,
+  concurrency_limit integer,
+  created_at integer NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS queue_items_partition ON queue_items (workflow_id, at, id);
+CREATE INDEX IF NOT EXISTS queue_items_concurrency ON queue_items (concurrency_key, leased_until) WHERE concurrency_key IS NOT NULL;
+
+-- queue_partitions stores a row for each function with enqueued items, used to
+-- fairly select functions to process.
+CREATE TABLE IF NOT EXISTS queue_partitions (
+  workflow_id text NOT NULL,
+  paused boolean NOT NULL DEFAULT false,
+  last_leased_at integer,
+  PRIMARY KEY (workflow_id)
+);
+
+-- queue_idempotency_keys ensures that each job ID is enqueued once.  Keys with a
+-- null expiry belong to items which are still enqueued.
+CREATE TABLE IF NOT EXISTS queue_idempotency_keys (
+  id text NOT NULL,
+  expires_at integer,
+  PRIMARY KEY (id)
+);
+
+-- queue_throttles stores the start time reserved for each throttled item.
+CREATE TABLE IF NOT EXISTS queue_throttles (
+  key text NOT NULL,
+  item_id text NOT NULL,
+  at integer NOT NULL,
+  expires_at integer NOT NULL,
+  PRIMARY KEY (key, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS queue_throttles_at ON queue_throttles (key, at);
+
+-- queue_dead_letters stores items which permanently failed, until they're
+-- requeued or purged.
+CREATE TABLE IF NOT EXISTS queue_dead_letters (
+  id text NOT NULL,
+  workflow_id text NOT NULL,
+  -- the JSON-encoded queue.DeadLetter
+  dead_letter text NOT NULL,
+  failed_at integer NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS queue_dead_letters_failed_at ON queue_dead_letters (failed_at, id);
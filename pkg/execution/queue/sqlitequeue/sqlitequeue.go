@@ -0,0 +1,62 @@
+package sqlitequeue
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+
+	"github.com/inngest/inngest/pkg/config/registration"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/queue/sqlqueue"
+	"github.com/inngest/inngest/pkg/sqldialect"
+	"github.com/inngest/inngest/pkg/sqliteutil"
+)
+
+//go:embed schema.sql
+var schema string
+
+func init() {
+	registration.RegisterQueue(func() any { return &Config{} })
+}
+
+// Config registers the configuration for the SQLite queue, and provides a
+// factory for the queue based off of the config.
+type Config struct {
+	// Path is the path to the SQLite database file, which is created if it
+	// doesn't exist.  This defaults to sqliteutil.DefaultPath.
+	Path string
+
+	sqlqueue.Options
+}
+
+func (c Config) QueueName() string { return "sqlite" }
+
+func (c Config) Queue() (osqueue.Queue, error) {
+	opts, err := c.Options.Opts()
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqliteutil.Open(context.Background(), c.Path, schema)
+	if err != nil {
+		return nil, fmt.Errorf("error opening sqlite queue: %w", err)
+	}
+	return sqlqueue.New(db, sqldialect.SQLite, opts...), nil
+}
+
+func (c Config) Producer() (osqueue.Producer, error) {
+	return c.Queue()
+}
+
+func (c Config) Consumer() (osqueue.Consumer, error) {
+	return c.Queue()
+}
+
+// New returns a queue which uses the given SQLite database as the backing store,
+// creating the queue's schema if necessary.
+func New(ctx context.Context, db *sql.DB, opts ...sqlqueue.Opt) (*sqlqueue.Queue, error) {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, fmt.Errorf("error applying sqlite queue schema: %w", err)
+	}
+	return sqlqueue.New(db, sqldialect.SQLite, opts...), nil
+}
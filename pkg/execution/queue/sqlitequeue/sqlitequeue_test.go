@@ -0,0 +1,26 @@
+package sqlitequeue
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/inngest/inngest/pkg/execution/queue/sqlqueue"
+	"github.com/inngest/inngest/pkg/execution/queue/sqlqueue/testharness"
+	"github.com/inngest/inngest/pkg/sqliteutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueHarness(t *testing.T) {
+	testharness.CheckQueue(t, func(t *testing.T, opts ...sqlqueue.Opt) (*sqlqueue.Queue, *sql.DB) {
+		ctx := context.Background()
+		db, err := sqliteutil.Open(ctx, filepath.Join(t.TempDir(), "inngest.db"), schema)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		q, err := New(ctx, db, opts...)
+		require.NoError(t, err)
+		return q, db
+	})
+}
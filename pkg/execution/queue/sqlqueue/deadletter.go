@@ -0,0 +1,201 @@
+package sqlqueue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+)
+
+var (
+	// Item IDs can be reused once their idempotency key expires, so a newer
+	// failure replaces the previous dead letter for the same ID.
+	sqlInsertDeadLetter = `
+		INSERT INTO queue_dead_letters (id, workflow_id, dead_letter, failed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET workflow_id = excluded.workflow_id,
+		dead_letter = excluded.dead_letter, failed_at = excluded.failed_at`
+	sqlDeadLetters = `
+		SELECT dead_letter FROM queue_dead_letters
+		ORDER BY failed_at DESC, id DESC
+		LIMIT $1`
+	sqlDeadLetter = `
+		SELECT dead_letter FROM queue_dead_letters WHERE id = $1`
+	sqlDeleteDeadLetter = `
+		DELETE FROM queue_dead_letters WHERE id = $1`
+	sqlPurgeAllDeadLetters = `
+		DELETE FROM queue_dead_letters`
+	// This is formatted with the dialect's condition for matching an array of
+	// IDs.
+	sqlPurgeDeadLetters = `
+		DELETE FROM queue_dead_letters WHERE %s`
+)
+
+// deadLetter removes a permanently failed item from the queue, storing it within
+// the dead-letter queue with the error that failed the item.
+func (q *Queue) deadLetter(ctx context.Context, i QueueItem, cause error) error {
+	data := i.Data
+	return q.tx(ctx, func(tx *sql.Tx) error {
+		return q.storeDeadLetter(ctx, tx, osqueue.DeadLetter{
+			ID:         i.ID,
+			WorkflowID: i.WorkflowID,
+			Item:       &data,
+			Error:      cause.Error(),
+			Attempt:    i.Data.Attempt,
+			At:         i.At,
+			FailedAt:   time.Now(),
+		})
+	})
+}
+
+// deadLetterRaw returns the dead letter for an item which can't be unmarshalled,
+// storing the item as it was read from the queue.
+func deadLetterRaw(i QueueItem, raw string, cause error) osqueue.DeadLetter {
+	return osqueue.DeadLetter{
+		ID:         i.ID,
+		WorkflowID: i.WorkflowID,
+		Raw:        raw,
+		Error:      fmt.Sprintf("error unmarshalling queue item: %s", cause),
+		At:         i.At,
+		FailedAt:   time.Now(),
+	}
+}
+
+// storeDeadLetter removes the dead letter's item from the queue within the given
+// transaction, storing the dead letter.  As with Dequeue, the item's ID can't be
+// enqueued again until the queue's idempotency TTL passes.
+func (q *Queue) storeDeadLetter(ctx context.Context, tx *sql.Tx, dl osqueue.DeadLetter) error {
+	byt, err := json.Marshal(dl)
+	if err != nil {
+		return fmt.Errorf("error marshalling dead letter: %w", err)
+	}
+
+	res, err := tx.ExecContext(ctx, sqlDeleteItem, dl.ID)
+	if err != nil {
+		return fmt.Errorf("error dead-lettering item: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrQueueItemNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, sqlExpireIdempotencyKey, dl.ID, q.dialect.Time(time.Now().Add(q.idempotencyTTL))); err != nil {
+		return fmt.Errorf("error dead-lettering item: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, sqlInsertDeadLetter, dl.ID, dl.WorkflowID, string(byt), q.dialect.Time(dl.FailedAt)); err != nil {
+		return fmt.Errorf("error dead-lettering item: %w", err)
+	}
+	return nil
+}
+
+// DeadLetters returns up to limit dead letters, most recently failed first.
+func (q *Queue) DeadLetters(ctx context.Context, limit int64) ([]osqueue.DeadLetter, error) {
+	if limit <= 0 {
+		limit = defaultDeadLetterLimit
+	}
+
+	rows, err := q.db.QueryContext(ctx, sqlDeadLetters, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error reading dead letters: %w", err)
+	}
+	defer rows.Close()
+
+	result := []osqueue.DeadLetter{}
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		dl := osqueue.DeadLetter{}
+		if err := json.Unmarshal([]byte(data), &dl); err != nil {
+			return nil, fmt.Errorf("error unmarshalling dead letter: %w", err)
+		}
+		result = append(result, dl)
+	}
+	return result, rows.Err()
+}
+
+// DeadLetter returns a single dead letter by its ID.
+func (q *Queue) DeadLetter(ctx context.Context, id string) (*osqueue.DeadLetter, error) {
+	var data string
+	err := q.db.QueryRowContext(ctx, sqlDeadLetter, id).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, osqueue.ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading dead letter: %w", err)
+	}
+	dl := &osqueue.DeadLetter{}
+	if err := json.Unmarshal([]byte(data), dl); err != nil {
+		return nil, fmt.Errorf("error unmarshalling dead letter: %w", err)
+	}
+	return dl, nil
+}
+
+// RequeueDeadLetter enqueues the dead letter's item to run immediately with its
+// attempts reset, removing the dead letter within the same transaction.
+//
+// The item is enqueued with a new ID, as the failed item's ID is still held for
+// idempotency.
+func (q *Queue) RequeueDeadLetter(ctx context.Context, id string) error {
+	dl, err := q.DeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+	if dl.Item == nil {
+		return osqueue.ErrDeadLetterUnreadable
+	}
+
+	item := *dl.Item
+	item.Attempt = 0
+	err = q.tx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, sqlDeleteDeadLetter, id)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			// The dead letter was requeued or purged since being read.
+			return osqueue.ErrDeadLetterNotFound
+		}
+
+		_, err = q.enqueue(ctx, tx, QueueItem{WorkflowID: dl.WorkflowID, Data: item}, time.Now())
+		return err
+	})
+	if err == osqueue.ErrDeadLetterNotFound {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("error requeueing dead letter: %w", err)
+	}
+
+	q.metrics.Counter("items_enqueued").Inc(1)
+	return nil
+}
+
+// PurgeDeadLetters removes the given dead letters, or every dead letter if no IDs
+// are given, returning the number of dead letters removed.
+func (q *Queue) PurgeDeadLetters(ctx context.Context, ids ...string) (int64, error) {
+	var (
+		res sql.Result
+		err error
+	)
+	if len(ids) == 0 {
+		res, err = q.db.ExecContext(ctx, sqlPurgeAllDeadLetters)
+	} else {
+		var arr any
+		if arr, err = q.dialect.Array(ids); err != nil {
+			return 0, err
+		}
+		res, err = q.db.ExecContext(ctx, q.sqlPurgeDeadLetters, arr)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("error purging dead letters: %w", err)
+	}
+	return res.RowsAffected()
+}
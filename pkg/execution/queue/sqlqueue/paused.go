@@ -0,0 +1,58 @@
+package sqlqueue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	sqlPauseFunction = `
+		INSERT INTO queue_partitions (workflow_id, paused)
+		VALUES ($1, true)
+		ON CONFLICT (workflow_id) DO UPDATE SET paused = true`
+	sqlResumeFunction = `
+		UPDATE queue_partitions SET paused = false
+		WHERE workflow_id = $1`
+	sqlPausedFunctions = `
+		SELECT workflow_id FROM queue_partitions
+		WHERE paused
+		ORDER BY workflow_id`
+)
+
+// PauseFunction stops the given function's items from being leased until the
+// function is resumed.  Items can still be enqueued for paused functions.
+func (q *Queue) PauseFunction(ctx context.Context, workflowID uuid.UUID) error {
+	if _, err := q.db.ExecContext(ctx, sqlPauseFunction, workflowID); err != nil {
+		return fmt.Errorf("error pausing function: %w", err)
+	}
+	return nil
+}
+
+// ResumeFunction allows the given function's items to be leased.
+func (q *Queue) ResumeFunction(ctx context.Context, workflowID uuid.UUID) error {
+	if _, err := q.db.ExecContext(ctx, sqlResumeFunction, workflowID); err != nil {
+		return fmt.Errorf("error resuming function: %w", err)
+	}
+	return nil
+}
+
+// PausedFunctions returns the IDs of all paused functions.
+func (q *Queue) PausedFunctions(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, sqlPausedFunctions)
+	if err != nil {
+		return nil, fmt.Errorf("error loading paused functions: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
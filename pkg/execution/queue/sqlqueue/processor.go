@@ -0,0 +1,270 @@
+package sqlqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/logger"
+)
+
+func (q *Queue) Run(ctx context.Context, f osqueue.RunFunc) error {
+	// Stop all workers when the queue quits.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for i := int32(0); i < q.numWorkers; i++ {
+		go q.worker(ctx, f)
+	}
+
+	go q.cleanupExpired(ctx)
+
+	tick := time.NewTicker(q.pollTick)
+	defer tick.Stop()
+
+	logger.From(ctx).Debug().Msg("starting queue worker")
+
+LOOP:
+	for {
+		select {
+		case <-ctx.Done():
+			// Kill signal
+			break LOOP
+		case err := <-q.quit:
+			// An inner function received an error which was deemed irrecoverable, so
+			// we're quitting the queue.
+			logger.From(ctx).Error().Err(err).Msg("quitting runner internally")
+			break LOOP
+		case <-tick.C:
+			if q.capacity() == 0 {
+				continue
+			}
+			if err := q.scan(ctx); err != nil {
+				if errors.Is(err, context.Canceled) {
+					break LOOP
+				}
+				// Scans may fail due to transient database errors;  items
+				// remain in the queue and are leased in the next scan.
+				logger.From(ctx).Error().Err(err).Msg("error scanning queue")
+			}
+		}
+	}
+
+	// Wait for all in-progress items to complete.
+	q.wg.Wait()
+
+	return nil
+}
+
+// cleanupExpired periodically removes expired idempotency keys and throttle
+// reservations.
+func (q *Queue) cleanupExpired(ctx context.Context) {
+	tick := time.NewTicker(cleanupInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			if err := q.Cleanup(ctx, time.Now()); err != nil {
+				logger.From(ctx).Error().Err(err).Msg("error cleaning up queue")
+			}
+		}
+	}
+}
+
+// scan leases as many available items as there are free workers, passing each
+// leased item to a worker.
+func (q *Queue) scan(ctx context.Context) error {
+	items, err := q.Lease(ctx, time.Now(), q.capacity())
+	if err != nil {
+		return err
+	}
+	for _, qi := range items {
+		// The workers channel is buffered to the number of workers, and we never
+		// lease more than the free capacity, so this never blocks.
+		atomic.AddInt64(&q.active, 1)
+		q.workers <- qi
+	}
+	return nil
+}
+
+// worker runs a blocking process that listens to items being pushed into the
+// worker channel.  This allows us to process an individual item from a queue.
+func (q *Queue) worker(ctx context.Context, f osqueue.RunFunc) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case qi := <-q.workers:
+			// Create a new context which isn't cancelled by the parent, when quit.
+			processCtx, cancel := context.WithCancel(context.Background())
+			err := q.process(processCtx, qi, f)
+			cancel()
+			atomic.AddInt64(&q.active, -1)
+			if err == nil {
+				continue
+			}
+
+			// We handle the error individually within process, requeueing
+			// the item into the queue.  Here, the worker can continue as
+			// usual to process the next item.
+			logger.From(ctx).Error().Err(err).Msg("error processing queue item")
+		}
+	}
+}
+
+func (q *Queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) error {
+	leaseID := *qi.LeaseID
+
+	// Allow the main runner to block until this work is done.  Items which
+	// aren't picked up by a worker before the runner stops are leased again
+	// once their lease expires.
+	q.wg.Add(1)
+	defer q.wg.Done()
+
+	// Continually extend the lease while this job is being processed.
+	extendLeaseTick := time.NewTicker(QueueLeaseDuration / 2)
+	defer extendLeaseTick.Stop()
+
+	q.metrics.Counter("items_processed").Inc(1)
+	q.metrics.Gauge("items_in_flight").Update(float64(atomic.AddInt64(&q.inFlight, 1)))
+	defer func() {
+		q.metrics.Gauge("items_in_flight").Update(float64(atomic.AddInt64(&q.inFlight, -1)))
+	}()
+	q.metrics.Timer("scheduling_latency").Record(time.Since(qi.At))
+
+	// errCh is buffered so that the job, lease extension, and max duration timer
+	// never block on sending once the first error has been handled.
+	errCh := make(chan error, 3)
+	doneCh := make(chan struct{})
+
+	// Continually extend lease in the background while we're working on this job
+	go func() {
+		for {
+			select {
+			case <-doneCh:
+				return
+			case <-extendLeaseTick.C:
+				if ctx.Err() != nil {
+					// Don't extend lease when the ctx is done.
+					return
+				}
+				next, err := q.ExtendLease(ctx, qi, leaseID, QueueLeaseDuration)
+				if err == ErrQueueItemNotFound {
+					return
+				}
+				if err != nil {
+					q.metrics.Counter("lease_extension_errors").Inc(1)
+					logger.From(ctx).Error().Err(err).Msg("error extending lease")
+					errCh <- fmt.Errorf("error extending lease while processing: %w", err)
+					return
+				}
+				leaseID = *next
+			}
+		}
+	}()
+
+	jobCtx, jobCancel := context.WithCancel(ctx)
+	defer jobCancel()
+
+	go func() {
+		runCtx := jobCtx
+		if dur := q.maxDuration(qi); dur > 0 {
+			// Cancel the job once it exceeds its max duration, and retry it
+			// without waiting for the job to return.
+			var runCancel context.CancelFunc
+			runCtx, runCancel = context.WithTimeout(jobCtx, dur)
+			defer runCancel()
+			go func() {
+				<-runCtx.Done()
+				if runCtx.Err() == context.DeadlineExceeded {
+					errCh <- osqueue.JobTimeoutError{MaxDuration: dur}
+				}
+			}()
+		}
+
+		err := f(runCtx, qi.Data)
+		extendLeaseTick.Stop()
+		if err != nil {
+			q.metrics.Counter("items_errored").Inc(1)
+			errCh <- err
+			return
+		}
+		// Closing this channel prevents the goroutine which extends lease from leaking,
+		// and dequeues the job
+		close(doneCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		// Job errored or extending lease errored.  Cancel the job ASAP.
+		jobCancel()
+
+		if _, ok := err.(osqueue.JobTimeoutError); ok {
+			// The job may have completed as it timed out.  Give the job a
+			// moment to return, using its result if it does so that completed
+			// jobs aren't retried.
+			select {
+			case err = <-errCh:
+			case <-doneCh:
+				if err := q.Dequeue(ctx, qi); err != nil && err != ErrQueueItemNotFound {
+					return err
+				}
+				return nil
+			case <-time.After(jobTimeoutGrace):
+			}
+		}
+
+		if osqueue.ShouldRetry(err, qi.Data.Attempt, qi.Data.GetMaxAttempts()) {
+			q.metrics.Counter("items_requeued").Inc(1)
+			qi.Data.Attempt += 1
+			at := qi.Data.RetryAt()
+			logger.From(ctx).Info().Err(err).Int64("at_ms", at.UnixMilli()).Interface("item", qi).Msg("requeuing job")
+			if err := q.Requeue(ctx, qi, at); err != nil {
+				logger.From(ctx).Error().Err(err).Interface("item", qi).Msg("error requeuing job")
+				return err
+			}
+			return nil
+		}
+
+		// Move this to the dead-letter queue, as this permanently failed.
+		q.metrics.Counter("items_dead_lettered").Inc(1)
+		logger.From(ctx).Info().Err(err).Interface("item", qi).Msg("dead-lettering failed job")
+		if err := q.deadLetter(ctx, qi, err); err != nil && err != ErrQueueItemNotFound {
+			return err
+		}
+
+		if _, ok := err.(osqueue.QuitError); ok {
+			select {
+			case q.quit <- err:
+			default:
+			}
+			return err
+		}
+
+	case <-doneCh:
+		if err := q.Dequeue(ctx, qi); err != nil && err != ErrQueueItemNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// maxDuration returns the maximum time that the given item can run for, or zero
+// if the item can run indefinitely.
+func (q *Queue) maxDuration(qi QueueItem) time.Duration {
+	if qi.Data.MaxDuration > 0 {
+		return qi.Data.MaxDuration
+	}
+	return q.maxJobDuration
+}
+
+// capacity returns the number of workers free to process newly leased items.
+func (q *Queue) capacity() int64 {
+	return int64(q.numWorkers) - atomic.LoadInt64(&q.active)
+}
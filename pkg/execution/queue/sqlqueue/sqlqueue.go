@@ -0,0 +1,730 @@
+// Package sqlqueue implements the queue using a SQL database.  The Postgres and
+// SQLite queues wrap this package with their connection config and schemas.
+package sqlqueue
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/metrics"
+	"github.com/inngest/inngest/pkg/sqldialect"
+	"github.com/oklog/ulid/v2"
+	"github.com/uber-go/tally/v4"
+	"github.com/xhit/go-str2duration/v2"
+)
+
+const (
+	// QueueLeaseDuration dictates how long a worker holds the lease for an item.
+	// Leases are extended while items are processed, so that items leased by
+	// dead workers become available once their lease expires.
+	QueueLeaseDuration = 10 * time.Second
+
+	// PartitionSelectionMax is the maximum number of functions that items are
+	// leased from in each scan.
+	PartitionSelectionMax int64 = 20
+
+	defaultNumWorkers     = 100
+	defaultPollTick       = 100 * time.Millisecond
+	defaultIdempotencyTTL = 12 * time.Hour
+	// defaultDeadLetterLimit is the number of dead letters returned when no
+	// limit is given.
+	defaultDeadLetterLimit = 1000
+	// jobTimeoutGrace is how long a job which exceeded its max duration is
+	// given to return before it's retried.
+	jobTimeoutGrace = 100 * time.Millisecond
+	// cleanupInterval is the interval between each removal of expired
+	// idempotency keys and throttle reservations.
+	cleanupInterval = time.Minute
+)
+
+// Advisory lock classes, used as the first key of two-key advisory locks so
+// that throttle and concurrency keys never contend with each other.
+const (
+	lockClassThrottle    = 1
+	lockClassConcurrency = 2
+)
+
+var (
+	ErrQueueItemExists        = osqueue.ErrQueueItemExists
+	ErrQueueItemNotFound      = fmt.Errorf("queue item not found")
+	ErrQueueItemLeaseMismatch = fmt.Errorf("item lease does not match")
+)
+
+// Options configures the queue's workers, shared by the config of each SQL
+// queue.
+type Options struct {
+	// NumWorkers is the number of queue items processed concurrently by
+	// each consumer.
+	NumWorkers int32
+	// PollTick is the interval between each scan for jobs, as a duration
+	// string (eg. "100ms").
+	PollTick string
+	// IdempotencyTTL is the duration for which job IDs are remembered after
+	// being dequeued, as a duration string (eg. "12h").
+	IdempotencyTTL string
+	// MaxJobDuration is the default maximum time that each job can run for,
+	// as a duration string (eg. "10m").  Jobs which run for longer are
+	// cancelled and retried.  This defaults to no limit.
+	MaxJobDuration string
+}
+
+// Opts parses the options, returning the Opts used to create the queue.
+func (o Options) Opts() ([]Opt, error) {
+	opts := []Opt{}
+	if o.NumWorkers > 0 {
+		opts = append(opts, WithNumWorkers(o.NumWorkers))
+	}
+	if o.PollTick != "" {
+		dur, err := str2duration.ParseDuration(o.PollTick)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing queue poll tick: %w", err)
+		}
+		opts = append(opts, WithPollTick(dur))
+	}
+	if o.IdempotencyTTL != "" {
+		dur, err := str2duration.ParseDuration(o.IdempotencyTTL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing queue idempotency ttl: %w", err)
+		}
+		opts = append(opts, WithIdempotencyTTL(dur))
+	}
+	if o.MaxJobDuration != "" {
+		dur, err := str2duration.ParseDuration(o.MaxJobDuration)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing queue max job duration: %w", err)
+		}
+		opts = append(opts, WithMaxJobDuration(dur))
+	}
+	return opts, nil
+}
+
+// Opt represents an option to use when creating a SQL-backed queue.
+type Opt func(q *Queue)
+
+// New returns a queue which stores items within the given database, using
+// queries for the given dialect.  The database's queue tables must exist.
+func New(db *sql.DB, dialect sqldialect.Dialect, opts ...Opt) *Queue {
+	q := &Queue{
+		db:             db,
+		dialect:        dialect,
+		metrics:        metrics.Scope().SubScope("queue"),
+		numWorkers:     defaultNumWorkers,
+		pollTick:       defaultPollTick,
+		idempotencyTTL: defaultIdempotencyTTL,
+		wg:             &sync.WaitGroup{},
+		quit:           make(chan error, 1),
+
+		sqlPeekPartitions: fmt.Sprintf(sqlPeekPartitions, dialect.SkipLocked),
+		sqlPeekItems:      fmt.Sprintf(sqlPeekItems, dialect.SkipLocked),
+
+		sqlPurgeDeadLetters: fmt.Sprintf(sqlPurgeDeadLetters, dialect.In("id", "$1")),
+	}
+
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	q.workers = make(chan QueueItem, q.numWorkers)
+	return q
+}
+
+func WithMetricsScope(scope tally.Scope) Opt {
+	return func(q *Queue) {
+		q.metrics = scope
+	}
+}
+
+func WithNumWorkers(n int32) Opt {
+	return func(q *Queue) {
+		q.numWorkers = n
+	}
+}
+
+func WithPollTick(t time.Duration) Opt {
+	return func(q *Queue) {
+		q.pollTick = t
+	}
+}
+
+func WithIdempotencyTTL(t time.Duration) Opt {
+	return func(q *Queue) {
+		q.idempotencyTTL = t
+	}
+}
+
+// WithMaxJobDuration limits how long jobs run for when their item doesn't
+// specify a MaxDuration.
+func WithMaxJobDuration(t time.Duration) Opt {
+	return func(q *Queue) {
+		q.maxJobDuration = t
+	}
+}
+
+// Queue is a queue backed by a SQL database.
+type Queue struct {
+	db      *sql.DB
+	dialect sqldialect.Dialect
+	// metrics allows reporting of metrics
+	metrics tally.Scope
+
+	idempotencyTTL time.Duration
+	// maxJobDuration is the default maximum time that each job can run for.
+	// If zero, jobs can run indefinitely.
+	maxJobDuration time.Duration
+	// pollTick is the interval between each scan for jobs.
+	pollTick time.Duration
+	// quit receives an error when a job requests that the queue stops running.
+	quit chan error
+	// wg stores a waitgroup for all in-progress jobs
+	wg *sync.WaitGroup
+	// numWorkers stores the number of workers available to concurrently process jobs.
+	numWorkers int32
+	// workers is a buffered channel which allows the scanner to send leased
+	// queue items to workers to be processed
+	workers chan QueueItem
+	// active stores the number of leased items which are queued for or being
+	// processed by workers.
+	active int64
+	// inFlight stores the number of items currently being processed, reported
+	// as a gauge.
+	inFlight int64
+
+	// sqlPeekPartitions and sqlPeekItems are the dialect's peek queries.
+	sqlPeekPartitions string
+	sqlPeekItems      string
+	// sqlPurgeDeadLetters is the dialect's query for purging dead letters by ID.
+	sqlPurgeDeadLetters string
+}
+
+// QueueItem represents an individually queued item of work.
+type QueueItem struct {
+	// ID represents a unique identifier for the queue item.  Using the same
+	// ID provides idempotency guarantees within the queue's IdempotencyTTL.
+	ID         string
+	WorkflowID uuid.UUID
+	// At is the time that the item becomes available to workers.
+	At time.Time
+	// LeaseID is a ULID which embeds a timestamp denoting when the lease
+	// expires, if the item is leased.
+	LeaseID *ulid.ULID
+	// Data represents the enqueued data, eg. the edge to process or the pause
+	// to resume.
+	Data osqueue.Item
+}
+
+var (
+	// An idempotency key with a null expiry is held by an enqueued item.  Expired
+	// keys can be claimed again.
+	sqlClaimIdempotencyKey = `
+		INSERT INTO queue_idempotency_keys (id)
+		VALUES ($1)
+		ON CONFLICT (id) DO UPDATE SET expires_at = NULL
+		WHERE queue_idempotency_keys.expires_at IS NOT NULL AND queue_idempotency_keys.expires_at <= $2`
+	sqlExpireIdempotencyKey = `
+		UPDATE queue_idempotency_keys SET expires_at = $2
+		WHERE id = $1`
+	sqlDeleteExpiredIdempotencyKeys = `
+		DELETE FROM queue_idempotency_keys WHERE expires_at <= $1`
+
+	sqlInsertPartition = `
+		INSERT INTO queue_partitions (workflow_id)
+		VALUES ($1)
+		ON CONFLICT DO NOTHING`
+	sqlInsertItem = `
+		INSERT INTO queue_items (id, workflow_id, at, item, concurrency_key, concurrency_limit, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	// Partitions are selected least recently leased first, so that every
+	// function with available items is processed in turn.  Partitions locked by
+	// other workers are skipped, as are functions whose only available items
+	// are at their concurrency limit.  This is formatted with the dialect's
+	// SkipLocked clause.
+	sqlPeekPartitions = `
+		SELECT p.workflow_id FROM queue_partitions p
+		WHERE NOT p.paused AND EXISTS (
+			SELECT 1 FROM queue_items i
+			WHERE i.workflow_id = p.workflow_id AND i.at <= $1
+			AND (i.leased_until IS NULL OR i.leased_until <= $1)
+			AND ` + sqlHasCapacity("$1") + `
+		)
+		ORDER BY p.last_leased_at NULLS FIRST
+		LIMIT $2
+		%s`
+	sqlPartitionLeased = `
+		UPDATE queue_partitions SET last_leased_at = $2
+		WHERE workflow_id = $1`
+	// Items whose concurrency key is at its limit are skipped, so that they
+	// don't fill the peek and block available items.  This is formatted with
+	// the dialect's SkipLocked clause.
+	sqlPeekItems = `
+		SELECT i.id, i.at, i.item, i.concurrency_key, i.concurrency_limit FROM queue_items i
+		WHERE i.workflow_id = $1 AND i.at <= $2
+		AND (i.leased_until IS NULL OR i.leased_until <= $2)
+		AND ` + sqlHasCapacity("$2") + `
+		ORDER BY i.at, i.id
+		LIMIT $3
+		%s`
+	sqlConcurrencyCount = `
+		SELECT count(*) FROM queue_items
+		WHERE concurrency_key = $1 AND leased_until > $2`
+
+	sqlLeaseItem = `
+		UPDATE queue_items SET lease_id = $2, leased_until = $3
+		WHERE id = $1`
+	sqlExtendLease = `
+		UPDATE queue_items SET lease_id = $3, leased_until = $4
+		WHERE id = $1 AND lease_id = $2`
+	sqlItemExists = `
+		SELECT 1 FROM queue_items WHERE id = $1`
+	sqlRequeueItem = `
+		UPDATE queue_items SET at = $2, item = $3, lease_id = NULL, leased_until = NULL
+		WHERE id = $1`
+	sqlDeleteItem = `
+		DELETE FROM queue_items WHERE id = $1`
+
+	sqlThrottleReserved = `
+		SELECT at FROM queue_throttles WHERE key = $1 AND item_id = $2`
+	sqlThrottleTrim = `
+		DELETE FROM queue_throttles WHERE key = $1 AND at <= $2`
+	sqlThrottlePrior = `
+		SELECT at FROM queue_throttles WHERE key = $1
+		ORDER BY at DESC
+		LIMIT 1 OFFSET $2`
+	sqlThrottleReserve = `
+		INSERT INTO queue_throttles (key, item_id, at, expires_at)
+		VALUES ($1, $2, $3, $4)`
+	sqlDeleteExpiredThrottles = `
+		DELETE FROM queue_throttles WHERE expires_at <= $1`
+)
+
+// sqlHasCapacity returns a condition filtering queue items aliased as "i" to those
+// whose concurrency key has capacity at the time given by the now placeholder.
+// Expired leases, eg. from dead workers, don't count towards the limit.
+func sqlHasCapacity(now string) string {
+	return `(i.concurrency_key IS NULL OR (
+			SELECT count(*) FROM queue_items c
+			WHERE c.concurrency_key = i.concurrency_key AND c.leased_until > ` + now + `
+		) < i.concurrency_limit)`
+}
+
+func (q *Queue) Enqueue(ctx context.Context, item osqueue.Item, at time.Time) error {
+	id := ""
+	if item.JobID != nil {
+		id = *item.JobID
+	}
+
+	_, err := q.EnqueueItem(ctx, QueueItem{
+		ID:         id,
+		WorkflowID: item.Identifier.WorkflowID,
+		Data:       item,
+	}, at)
+	if err != nil {
+		return err
+	}
+	logger.From(ctx).Debug().Interface("item", item).Msg("enqueued item")
+	return nil
+}
+
+// EnqueueItem enqueues a QueueItem to become available at the given time.  If the
+// QueueItem has no ID a new ID is created for the queue item.
+//
+// This returns ErrQueueItemExists if an item with the same ID is enqueued, or was
+// dequeued within the queue's idempotency TTL.
+func (q *Queue) EnqueueItem(ctx context.Context, i QueueItem, at time.Time) (QueueItem, error) {
+	err := q.tx(ctx, func(tx *sql.Tx) error {
+		var err error
+		i, err = q.enqueue(ctx, tx, i, at)
+		return err
+	})
+	if err != nil {
+		return i, err
+	}
+
+	q.metrics.Counter("items_enqueued").Inc(1)
+	return i, nil
+}
+
+// enqueue enqueues the item within the given transaction, returning the item with
+// its ID and start time.
+func (q *Queue) enqueue(ctx context.Context, tx *sql.Tx, i QueueItem, at time.Time) (QueueItem, error) {
+	if len(i.ID) == 0 {
+		i.ID = ulid.MustNew(ulid.Now(), rand.Reader).String()
+	}
+
+	byt, err := json.Marshal(i.Data)
+	if err != nil {
+		return i, fmt.Errorf("error marshalling queue item: %w", err)
+	}
+
+	var (
+		concurrencyKey   sql.NullString
+		concurrencyLimit sql.NullInt64
+	)
+	if c := i.Data.Identifier.Concurrency; c != nil && c.Limit > 0 {
+		concurrencyKey = sql.NullString{String: c.Key, Valid: true}
+		concurrencyLimit = sql.NullInt64{Int64: int64(c.Limit), Valid: true}
+	}
+
+	now := time.Now()
+
+	res, err := tx.ExecContext(ctx, sqlClaimIdempotencyKey, i.ID, q.dialect.Time(now))
+	if err != nil {
+		return i, fmt.Errorf("error claiming queue item id: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return i, err
+	} else if n == 0 {
+		return i, ErrQueueItemExists
+	}
+
+	if i.Data.Throttle != nil {
+		// Reserve a slot within the throttle window, delaying the item
+		// if the throttle's limit has been reached.
+		if at, err = q.throttle(ctx, tx, i.ID, *i.Data.Throttle, at); err != nil {
+			return i, err
+		}
+	}
+	i.At = at
+
+	if _, err := tx.ExecContext(ctx, sqlInsertPartition, i.WorkflowID); err != nil {
+		return i, fmt.Errorf("error inserting queue partition: %w", err)
+	}
+	if _, err := tx.ExecContext(
+		ctx,
+		sqlInsertItem,
+		i.ID,
+		i.WorkflowID,
+		q.dialect.Time(i.At),
+		string(byt),
+		concurrencyKey,
+		concurrencyLimit,
+		q.dialect.Time(now),
+	); err != nil {
+		return i, fmt.Errorf("error enqueueing item: %w", err)
+	}
+	return i, nil
+}
+
+// throttle reserves a start time for the given item within the throttle's key,
+// returning the earliest time that the item can start.  At most the throttle's
+// limit of items may start within any period;  items over the limit are pushed
+// back instead of dropped.
+func (q *Queue) throttle(ctx context.Context, tx *sql.Tx, itemID string, t osqueue.Throttle, at time.Time) (time.Time, error) {
+	if t.Limit <= 0 || t.Period <= 0 {
+		return at, nil
+	}
+	period := time.Duration(t.Period) * time.Second
+
+	// Serialize reservations for the key until the transaction ends.  Dialects
+	// without advisory locks serialize every write transaction.
+	if q.dialect.Lock != "" {
+		if _, err := tx.ExecContext(ctx, q.dialect.Lock, lockClassThrottle, t.Key); err != nil {
+			return at, fmt.Errorf("error locking throttle: %w", err)
+		}
+	}
+
+	var reserved sqldialect.Timestamp
+	err := tx.QueryRowContext(ctx, sqlThrottleReserved, t.Key, itemID).Scan(&reserved)
+	if err == nil {
+		return reserved.Time, nil
+	}
+	if err != sql.ErrNoRows {
+		return at, fmt.Errorf("error reading throttle: %w", err)
+	}
+
+	// Remove any reservations which no longer fall within the current window.
+	if _, err := tx.ExecContext(ctx, sqlThrottleTrim, t.Key, q.dialect.Time(at.Add(-period))); err != nil {
+		return at, fmt.Errorf("error trimming throttle: %w", err)
+	}
+
+	// The item must start at least one period after the limit-th most recent
+	// reservation, ensuring no window contains more than limit items.
+	var prior sqldialect.Timestamp
+	err = tx.QueryRowContext(ctx, sqlThrottlePrior, t.Key, t.Limit-1).Scan(&prior)
+	if err != nil && err != sql.ErrNoRows {
+		return at, fmt.Errorf("error reading throttle: %w", err)
+	}
+	if next := prior.Add(period); err == nil && next.After(at) {
+		at = next
+	}
+
+	if _, err := tx.ExecContext(ctx, sqlThrottleReserve, t.Key, itemID, q.dialect.Time(at), q.dialect.Time(at.Add(period))); err != nil {
+		return at, fmt.Errorf("error reserving throttle: %w", err)
+	}
+	return at, nil
+}
+
+// Lease leases up to limit items which are available at the given time, returning
+// the leased items.
+//
+// Items are leased fairly across functions:  the least recently leased functions
+// with available items are selected first, and each function receives an equal
+// share of the limit.  Functions and items being leased by other workers are
+// skipped, allowing many workers to lease from the queue concurrently.  Dialects
+// without row locks serialize leases using the database's write lock instead.
+func (q *Queue) Lease(ctx context.Context, now time.Time, limit int64) ([]QueueItem, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var leased []QueueItem
+	err := q.tx(ctx, func(tx *sql.Tx) error {
+		leased = nil
+
+		wids, err := q.peekPartitions(ctx, tx, now)
+		if err != nil {
+			return err
+		}
+		if len(wids) == 0 {
+			return nil
+		}
+
+		share := (limit + int64(len(wids)) - 1) / int64(len(wids))
+		for _, wid := range wids {
+			remaining := limit - int64(len(leased))
+			if remaining <= 0 {
+				// Functions which aren't marked as leased are selected
+				// first in the next scan.
+				break
+			}
+			if share > remaining {
+				share = remaining
+			}
+
+			items, err := q.leasePartition(ctx, tx, wid, now, share)
+			if err != nil {
+				return err
+			}
+			leased = append(leased, items...)
+
+			if _, err := tx.ExecContext(ctx, sqlPartitionLeased, wid, q.dialect.Time(now)); err != nil {
+				return fmt.Errorf("error updating queue partition: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error leasing items: %w", err)
+	}
+	return leased, nil
+}
+
+func (q *Queue) peekPartitions(ctx context.Context, tx *sql.Tx, now time.Time) ([]uuid.UUID, error) {
+	rows, err := tx.QueryContext(ctx, q.sqlPeekPartitions, q.dialect.Time(now), PartitionSelectionMax)
+	if err != nil {
+		return nil, fmt.Errorf("error peeking partitions: %w", err)
+	}
+	defer rows.Close()
+
+	wids := []uuid.UUID{}
+	for rows.Next() {
+		var wid uuid.UUID
+		if err := rows.Scan(&wid); err != nil {
+			return nil, err
+		}
+		wids = append(wids, wid)
+	}
+	return wids, rows.Err()
+}
+
+// leasePartition leases up to limit available items for the given function.
+func (q *Queue) leasePartition(ctx context.Context, tx *sql.Tx, wid uuid.UUID, now time.Time, limit int64) ([]QueueItem, error) {
+	type peeked struct {
+		item             QueueItem
+		concurrencyKey   sql.NullString
+		concurrencyLimit sql.NullInt64
+	}
+
+	rows, err := tx.QueryContext(ctx, q.sqlPeekItems, wid, q.dialect.Time(now), limit)
+	if err != nil {
+		return nil, fmt.Errorf("error peeking queue items: %w", err)
+	}
+	candidates := []peeked{}
+	unreadable := []osqueue.DeadLetter{}
+	for rows.Next() {
+		var (
+			p    = peeked{item: QueueItem{WorkflowID: wid}}
+			at   sqldialect.Timestamp
+			data string
+		)
+		if err := rows.Scan(&p.item.ID, &at, &data, &p.concurrencyKey, &p.concurrencyLimit); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		p.item.At = at.Time
+		if err := json.Unmarshal([]byte(data), &p.item.Data); err != nil {
+			logger.From(ctx).Error().Err(err).Str("id", p.item.ID).Str("item", data).Msg("dead-lettering unreadable queue item")
+			unreadable = append(unreadable, deadLetterRaw(p.item, data, err))
+			continue
+		}
+		candidates = append(candidates, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Unreadable items can never be processed;  move them to the dead-letter
+	// queue so that they don't block the partition.
+	for _, dl := range unreadable {
+		if err := q.storeDeadLetter(ctx, tx, dl); err != nil {
+			return nil, fmt.Errorf("error dead-lettering unreadable queue item: %w", err)
+		}
+	}
+
+	leased := []QueueItem{}
+	for _, p := range candidates {
+		if p.concurrencyKey.Valid {
+			ok, err := q.hasCapacity(ctx, tx, p.concurrencyKey.String, p.concurrencyLimit.Int64, now)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				// The function or key is at capacity;  leave the item in the
+				// queue until a running item completes.
+				q.metrics.Counter("items_concurrency_limited").Inc(1)
+				continue
+			}
+		}
+
+		until := now.Add(QueueLeaseDuration)
+		leaseID, err := ulid.New(ulid.Timestamp(until), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("error generating id: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, sqlLeaseItem, p.item.ID, leaseID.String(), q.dialect.Time(until)); err != nil {
+			return nil, fmt.Errorf("error leasing queue item: %w", err)
+		}
+
+		p.item.LeaseID = &leaseID
+		leased = append(leased, p.item)
+	}
+	return leased, nil
+}
+
+// hasCapacity returns whether another item with the given concurrency key can be
+// leased.  If another worker is leasing items with the same key, this returns
+// false and the item is retried in a future scan.
+func (q *Queue) hasCapacity(ctx context.Context, tx *sql.Tx, key string, limit int64, now time.Time) (bool, error) {
+	if q.dialect.TryLock != "" {
+		var locked bool
+		if err := tx.QueryRowContext(ctx, q.dialect.TryLock, lockClassConcurrency, key).Scan(&locked); err != nil {
+			return false, fmt.Errorf("error locking concurrency key: %w", err)
+		}
+		if !locked {
+			return false, nil
+		}
+	}
+
+	// Expired leases, eg. from dead workers, don't count towards the limit.
+	var n int64
+	if err := tx.QueryRowContext(ctx, sqlConcurrencyCount, key, q.dialect.Time(now)).Scan(&n); err != nil {
+		return false, fmt.Errorf("error counting concurrency: %w", err)
+	}
+	return n < limit, nil
+}
+
+// ExtendLease extends the lease for a given queue item, given the queue item is
+// currently leased with the given ID.  This returns the new lease ID.
+//
+// The existing lease ID must be passed in so that we can guarantee that the worker
+// renewing the lease still owns the lease.
+func (q *Queue) ExtendLease(ctx context.Context, i QueueItem, leaseID ulid.ULID, duration time.Duration) (*ulid.ULID, error) {
+	until := time.Now().Add(duration)
+	newLeaseID, err := ulid.New(ulid.Timestamp(until), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating id: %w", err)
+	}
+
+	res, err := q.db.ExecContext(ctx, sqlExtendLease, i.ID, leaseID.String(), newLeaseID.String(), q.dialect.Time(until))
+	if err != nil {
+		return nil, fmt.Errorf("error extending lease: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 1 {
+		return &newLeaseID, nil
+	}
+
+	var exists int
+	err = q.db.QueryRowContext(ctx, sqlItemExists, i.ID).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, ErrQueueItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error extending lease: %w", err)
+	}
+	return nil, ErrQueueItemLeaseMismatch
+}
+
+// Dequeue removes an item from the queue entirely.  The item's ID can't be
+// enqueued again until the queue's idempotency TTL passes.
+func (q *Queue) Dequeue(ctx context.Context, i QueueItem) error {
+	return q.tx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, sqlExpireIdempotencyKey, i.ID, q.dialect.Time(time.Now().Add(q.idempotencyTTL))); err != nil {
+			return fmt.Errorf("error dequeueing item: %w", err)
+		}
+		res, err := tx.ExecContext(ctx, sqlDeleteItem, i.ID)
+		if err != nil {
+			return fmt.Errorf("error dequeueing item: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return ErrQueueItemNotFound
+		}
+		return nil
+	})
+}
+
+// Requeue releases the item's lease and reschedules the item to run at the
+// given time, storing any changes to the item's data.
+func (q *Queue) Requeue(ctx context.Context, i QueueItem, at time.Time) error {
+	byt, err := json.Marshal(i.Data)
+	if err != nil {
+		return fmt.Errorf("error marshalling queue item: %w", err)
+	}
+
+	res, err := q.db.ExecContext(ctx, sqlRequeueItem, i.ID, q.dialect.Time(at), string(byt))
+	if err != nil {
+		return fmt.Errorf("error requeueing item: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrQueueItemNotFound
+	}
+	return nil
+}
+
+// Cleanup removes idempotency keys and throttle reservations which expired
+// before the given time.  This runs periodically while the queue runs.
+func (q *Queue) Cleanup(ctx context.Context, now time.Time) error {
+	if _, err := q.db.ExecContext(ctx, sqlDeleteExpiredIdempotencyKeys, q.dialect.Time(now)); err != nil {
+		return fmt.Errorf("error removing expired idempotency keys: %w", err)
+	}
+	if _, err := q.db.ExecContext(ctx, sqlDeleteExpiredThrottles, q.dialect.Time(now)); err != nil {
+		return fmt.Errorf("error removing expired throttles: %w", err)
+	}
+	return nil
+}
+
+func (q *Queue) tx(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,495 @@
+// Package testharness checks that SQL queues behave correctly for each
+// dialect.
+package testharness
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/queue/sqlqueue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/sqldialect"
+	"github.com/stretchr/testify/require"
+)
+
+// Generator returns an empty queue created with the given options, along with
+// the queue's database.
+type Generator func(t *testing.T, opts ...sqlqueue.Opt) (*sqlqueue.Queue, *sql.DB)
+
+// CheckQueue runs every check against queues created by the generator.
+func CheckQueue(t *testing.T, gen Generator) {
+	t.Helper()
+
+	funcs := map[string]func(t *testing.T, gen Generator){
+		"EnqueueItem": checkEnqueueItem,
+		"Lease":       checkLease,
+		"ExtendLease": checkExtendLease,
+		"Throttle":    checkThrottle,
+		"Run":         checkRun,
+		"DeadLetter":  checkDeadLetter,
+	}
+	for name, f := range funcs {
+		t.Run(name, func(t *testing.T) {
+			f(t, gen)
+		})
+	}
+}
+
+func checkEnqueueItem(t *testing.T, gen Generator) {
+	q, db := gen(t, sqlqueue.WithIdempotencyTTL(time.Second))
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+
+	var _ osqueue.Queue = q
+	var _ osqueue.FunctionPauser = q
+
+	t.Run("It generates IDs for items", func(t *testing.T) {
+		item, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: uuid.New()}, now)
+		require.NoError(t, err)
+		require.NotEmpty(t, item.ID)
+		require.WithinDuration(t, now, item.At, time.Millisecond)
+	})
+
+	t.Run("It deduplicates job IDs", func(t *testing.T) {
+		jobID := "job-id"
+		item := osqueue.Item{JobID: &jobID, Identifier: state.Identifier{WorkflowID: uuid.New()}}
+
+		require.NoError(t, q.Enqueue(ctx, item, now))
+		require.Equal(t, sqlqueue.ErrQueueItemExists, q.Enqueue(ctx, item, now))
+
+		// Dequeued job IDs are remembered until the idempotency TTL passes.
+		require.NoError(t, q.Dequeue(ctx, sqlqueue.QueueItem{ID: jobID}))
+		require.Equal(t, sqlqueue.ErrQueueItemExists, q.Enqueue(ctx, item, now))
+
+		<-time.After(time.Second)
+		require.NoError(t, q.Enqueue(ctx, item, now))
+	})
+
+	t.Run("It removes expired idempotency keys", func(t *testing.T) {
+		item, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: uuid.New()}, now)
+		require.NoError(t, err)
+		require.NoError(t, q.Dequeue(ctx, item))
+
+		require.NoError(t, q.Cleanup(ctx, time.Now().Add(2*time.Second)))
+		var n int
+		err = db.QueryRow(`SELECT count(*) FROM queue_idempotency_keys WHERE id = $1`, item.ID).Scan(&n)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+}
+
+func checkLease(t *testing.T, gen Generator) {
+	ctx := context.Background()
+
+	t.Run("It leases available items in order", func(t *testing.T) {
+		q, _ := gen(t)
+		now := time.Now()
+		wid := uuid.New()
+
+		for i := 0; i < 3; i++ {
+			_, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{
+				WorkflowID: wid,
+				Data:       osqueue.Item{Attempt: i},
+			}, now.Add(time.Duration(i)*time.Millisecond))
+			require.NoError(t, err)
+		}
+		// Future items aren't available until their time.
+		future, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: wid}, now.Add(time.Hour))
+		require.NoError(t, err)
+
+		items, err := q.Lease(ctx, now.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, items, 3)
+		for i, item := range items {
+			require.Equal(t, i, item.Data.Attempt)
+			require.NotNil(t, item.LeaseID)
+		}
+
+		// Leased items aren't leased again.
+		items, err = q.Lease(ctx, now.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Empty(t, items)
+
+		// Expired leases can be leased, eg. from dead workers.
+		items, err = q.Lease(ctx, now.Add(sqlqueue.QueueLeaseDuration+2*time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, items, 3)
+		for _, item := range items {
+			require.NoError(t, q.Dequeue(ctx, item))
+		}
+
+		items, err = q.Lease(ctx, now.Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		require.Equal(t, future.ID, items[0].ID)
+	})
+
+	t.Run("It leases items fairly between functions", func(t *testing.T) {
+		q, _ := gen(t)
+		now := time.Now()
+		busy, quiet := uuid.New(), uuid.New()
+
+		for i := 0; i < 10; i++ {
+			_, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: busy}, now)
+			require.NoError(t, err)
+		}
+		_, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: quiet}, now.Add(time.Millisecond))
+		require.NoError(t, err)
+
+		items, err := q.Lease(ctx, now.Add(time.Second), 2)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		require.ElementsMatch(t, []uuid.UUID{busy, quiet}, []uuid.UUID{items[0].WorkflowID, items[1].WorkflowID})
+	})
+
+	t.Run("It never leases items twice with concurrent workers", func(t *testing.T) {
+		q, _ := gen(t)
+		now := time.Now()
+
+		ids := map[string]bool{}
+		for i := 0; i < 100; i++ {
+			item, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: uuid.New()}, now)
+			require.NoError(t, err)
+			ids[item.ID] = true
+		}
+
+		mu := &sync.Mutex{}
+		leased := map[string]int{}
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for n := 0; n < 10; n++ {
+					items, err := q.Lease(ctx, now.Add(time.Second), 5)
+					require.NoError(t, err)
+					mu.Lock()
+					for _, item := range items {
+						leased[item.ID]++
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		for id, n := range leased {
+			require.True(t, ids[id])
+			require.Equal(t, 1, n, "item leased more than once")
+		}
+	})
+
+	t.Run("It respects concurrency limits", func(t *testing.T) {
+		q, _ := gen(t)
+		now := time.Now()
+		c := &state.Concurrency{Key: "limited", Limit: 2}
+
+		for i := 0; i < 3; i++ {
+			_, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{
+				WorkflowID: uuid.New(),
+				Data:       osqueue.Item{Identifier: state.Identifier{Concurrency: c}},
+			}, now)
+			require.NoError(t, err)
+		}
+
+		items, err := q.Lease(ctx, now.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+
+		// Capacity is available once a running item completes.
+		require.NoError(t, q.Dequeue(ctx, items[0]))
+		items, err = q.Lease(ctx, now.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+	})
+
+	t.Run("It doesn't block available items behind limited items", func(t *testing.T) {
+		q, _ := gen(t)
+		now := time.Now()
+		wid := uuid.New()
+		c := &state.Concurrency{Key: "blocking", Limit: 1}
+
+		for i := 0; i < 3; i++ {
+			_, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{
+				WorkflowID: wid,
+				Data:       osqueue.Item{Identifier: state.Identifier{Concurrency: c}},
+			}, now.Add(time.Duration(i)*time.Millisecond))
+			require.NoError(t, err)
+		}
+		available, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: wid}, now.Add(10*time.Millisecond))
+		require.NoError(t, err)
+
+		items, err := q.Lease(ctx, now.Add(time.Second), 2)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+
+		// The remaining limited items are earlier, but aren't peeked.
+		items, err = q.Lease(ctx, now.Add(time.Second), 2)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		require.Equal(t, available.ID, items[0].ID)
+	})
+
+	t.Run("It doesn't lease paused functions", func(t *testing.T) {
+		q, _ := gen(t)
+		now := time.Now()
+		wid := uuid.New()
+
+		_, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: wid}, now)
+		require.NoError(t, err)
+		require.NoError(t, q.PauseFunction(ctx, wid))
+
+		paused, err := q.PausedFunctions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{wid}, paused)
+
+		items, err := q.Lease(ctx, now.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Empty(t, items)
+
+		require.NoError(t, q.ResumeFunction(ctx, wid))
+		items, err = q.Lease(ctx, now.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+	})
+}
+
+func checkExtendLease(t *testing.T, gen Generator) {
+	q, _ := gen(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: uuid.New()}, now)
+	require.NoError(t, err)
+	items, err := q.Lease(ctx, now, 1)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	item := items[0]
+
+	next, err := q.ExtendLease(ctx, item, *item.LeaseID, time.Minute)
+	require.NoError(t, err)
+	require.NotEqual(t, *item.LeaseID, *next)
+
+	// The previous lease no longer owns the item.
+	_, err = q.ExtendLease(ctx, item, *item.LeaseID, time.Minute)
+	require.Equal(t, sqlqueue.ErrQueueItemLeaseMismatch, err)
+
+	require.NoError(t, q.Dequeue(ctx, item))
+	_, err = q.ExtendLease(ctx, item, *next, time.Minute)
+	require.Equal(t, sqlqueue.ErrQueueItemNotFound, err)
+}
+
+func checkThrottle(t *testing.T, gen Generator) {
+	q, _ := gen(t)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	throttle := &osqueue.Throttle{Key: "throttled", Limit: 2, Period: 60}
+
+	ats := []time.Time{}
+	for i := 0; i < 3; i++ {
+		item, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{
+			WorkflowID: uuid.New(),
+			Data:       osqueue.Item{Throttle: throttle},
+		}, now)
+		require.NoError(t, err)
+		ats = append(ats, item.At)
+	}
+
+	require.WithinDuration(t, now, ats[0], time.Millisecond)
+	require.WithinDuration(t, now, ats[1], time.Millisecond)
+	// The third item is delayed until a period after the first.
+	require.WithinDuration(t, now.Add(time.Minute), ats[2], time.Millisecond)
+}
+
+func checkRun(t *testing.T, gen Generator) {
+	q, db := gen(t, sqlqueue.WithNumWorkers(10), sqlqueue.WithPollTick(10*time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	defer func() {
+		// Stop the queue before its database is closed.
+		cancel()
+		<-done
+	}()
+
+	wid := uuid.New()
+	maxAttempts := 2
+	for i := 0; i < 5; i++ {
+		err := q.Enqueue(ctx, osqueue.Item{
+			Kind:        osqueue.KindEdge,
+			Identifier:  state.Identifier{WorkflowID: wid},
+			MaxAttempts: &maxAttempts,
+			Payload:     osqueue.PayloadEdge{},
+		}, time.Now())
+		require.NoError(t, err)
+	}
+
+	mu := &sync.Mutex{}
+	attempts := map[int]int{}
+	go func() {
+		defer close(done)
+		_ = q.Run(ctx, func(ctx context.Context, item osqueue.Item) error {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts[item.Attempt]++
+			if attempts[0] == 1 {
+				// Fail the first item, which is retried.
+				return fmt.Errorf("failed")
+			}
+			return nil
+		})
+	}()
+
+	// Successful items are dequeued, and the failed item is requeued for its
+	// next attempt.
+	require.Eventually(t, func() bool {
+		var total, requeued int
+		err := db.QueryRow(`SELECT count(*), count(*) FILTER (WHERE lease_id IS NULL) FROM queue_items`).Scan(&total, &requeued)
+		require.NoError(t, err)
+		return total == 1 && requeued == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	var (
+		at   sqldialect.Timestamp
+		data string
+	)
+	err := db.QueryRow(`SELECT at, item FROM queue_items WHERE lease_id IS NULL`).Scan(&at, &data)
+	require.NoError(t, err)
+	require.True(t, at.After(time.Now()))
+	item := osqueue.Item{}
+	require.NoError(t, json.Unmarshal([]byte(data), &item))
+	require.Equal(t, 1, item.Attempt)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, map[int]int{0: 5}, attempts)
+}
+
+func checkDeadLetter(t *testing.T, gen Generator) {
+	ctx := context.Background()
+
+	t.Run("It dead-letters unreadable items", func(t *testing.T) {
+		q, db := gen(t)
+		var _ osqueue.DeadLetterQueue = q
+
+		now := time.Now()
+		wid := uuid.New()
+
+		ids := []string{}
+		for i := 0; i < 2; i++ {
+			item, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: wid}, now)
+			require.NoError(t, err)
+			_, err = db.Exec(`UPDATE queue_items SET item = 'unreadable' WHERE id = $1`, item.ID)
+			require.NoError(t, err)
+			available, err := q.EnqueueItem(ctx, sqlqueue.QueueItem{WorkflowID: wid}, now)
+			require.NoError(t, err)
+
+			// The unreadable item doesn't block the partition.
+			items, err := q.Lease(ctx, now.Add(time.Second), 2)
+			require.NoError(t, err)
+			require.Len(t, items, 1)
+			require.Equal(t, available.ID, items[0].ID)
+			require.NoError(t, q.Dequeue(ctx, items[0]))
+
+			ids = append(ids, item.ID)
+			<-time.After(5 * time.Millisecond)
+		}
+
+		dl, err := q.DeadLetter(ctx, ids[0])
+		require.NoError(t, err)
+		require.Equal(t, wid, dl.WorkflowID)
+		require.Nil(t, dl.Item)
+		require.Equal(t, "unreadable", dl.Raw)
+		require.Contains(t, dl.Error, "error unmarshalling queue item")
+		require.Equal(t, osqueue.ErrDeadLetterUnreadable, q.RequeueDeadLetter(ctx, ids[0]))
+
+		// Dead letters are listed most recently failed first.
+		dls, err := q.DeadLetters(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, dls, 2)
+		require.Equal(t, ids[1], dls[0].ID)
+		require.Equal(t, ids[0], dls[1].ID)
+
+		n, err := q.PurgeDeadLetters(ctx, ids[0])
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+		_, err = q.DeadLetter(ctx, ids[0])
+		require.Equal(t, osqueue.ErrDeadLetterNotFound, err)
+
+		n, err = q.PurgeDeadLetters(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+		dls, err = q.DeadLetters(ctx, 0)
+		require.NoError(t, err)
+		require.Empty(t, dls)
+	})
+
+	t.Run("It dead-letters and requeues permanently failed items", func(t *testing.T) {
+		q, db := gen(t, sqlqueue.WithPollTick(10*time.Millisecond))
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		defer func() {
+			// Stop the queue before its database is closed.
+			cancel()
+			<-done
+		}()
+
+		maxAttempts := 1
+		err := q.Enqueue(ctx, osqueue.Item{
+			Kind:        osqueue.KindEdge,
+			Identifier:  state.Identifier{WorkflowID: uuid.New()},
+			MaxAttempts: &maxAttempts,
+			Payload:     osqueue.PayloadEdge{},
+		}, time.Now())
+		require.NoError(t, err)
+
+		mu := &sync.Mutex{}
+		calls := 0
+		go func() {
+			defer close(done)
+			_ = q.Run(runCtx, func(ctx context.Context, item osqueue.Item) error {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				if calls == 1 {
+					return fmt.Errorf("failed")
+				}
+				return nil
+			})
+		}()
+
+		var dls []osqueue.DeadLetter
+		require.Eventually(t, func() bool {
+			dls, err = q.DeadLetters(ctx, 10)
+			require.NoError(t, err)
+			return len(dls) == 1
+		}, 5*time.Second, 50*time.Millisecond)
+		require.NotNil(t, dls[0].Item)
+		require.Equal(t, osqueue.KindEdge, dls[0].Item.Kind)
+		require.Equal(t, "failed", dls[0].Error)
+		require.Equal(t, 0, dls[0].Attempt)
+
+		var n int
+		require.NoError(t, db.QueryRow(`SELECT count(*) FROM queue_items`).Scan(&n))
+		require.Zero(t, n)
+
+		// Requeued items run again and the dead letter is removed.
+		require.NoError(t, q.RequeueDeadLetter(ctx, dls[0].ID))
+		require.Equal(t, osqueue.ErrDeadLetterNotFound, q.RequeueDeadLetter(ctx, dls[0].ID))
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			require.NoError(t, db.QueryRow(`SELECT count(*) FROM queue_items`).Scan(&n))
+			return calls == 2 && n == 0
+		}, 5*time.Second, 50*time.Millisecond)
+		dls, err = q.DeadLetters(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, dls)
+	})
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
-	"github.com/inngest/inngest/pkg/backoff"
 	"github.com/inngest/inngest/pkg/config"
 	"github.com/inngest/inngest/pkg/config/registration"
 	"github.com/inngest/inngest/pkg/execution/queue"
@@ -183,7 +182,7 @@ func (i impl) Run(ctx context.Context, f queue.RunFunc) error {
 		err := f(ctx, w.Item)
 		if queue.ShouldRetry(err, w.Item.Attempt, w.Item.GetMaxAttempts()) {
 			w.Item.Attempt += 1
-			return i.Enqueue(ctx, w.Item, backoff.LinearJitterBackoff(w.Item.Attempt))
+			return i.Enqueue(ctx, w.Item, w.Item.RetryAt())
 		}
 		return nil
 	}, int64(i.config.Concurrency))
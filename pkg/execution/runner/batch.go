@@ -0,0 +1,171 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/expressions"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/xhit/go-str2duration/v2"
+)
+
+// batchFlusher initializes a function run with a full or timed out batch of events.
+type batchFlusher func(ctx context.Context, fn function.Function, evts []event.Event) error
+
+// batcher accumulates events for functions with batch triggers, starting a single
+// run for each batch once it reaches its max size or its timeout elapses.
+//
+// Batches are held in memory by each runner service.  When running multiple
+// runners, each runner accumulates its own batches from the events it receives,
+// and any batches pending when a runner stops are flushed immediately.  Batches
+// which fail to start a run keep their events and are retried once the batch
+// times out again.
+type batcher struct {
+	lock    sync.Mutex
+	batches map[string]*batch
+	flush   batchFlusher
+}
+
+// batch is a pending batch of events for a single function and batch key.
+type batch struct {
+	ctx     context.Context
+	fn      function.Function
+	timeout time.Duration
+	events  []event.Event
+	timer   *time.Timer
+}
+
+func newBatcher(f batchFlusher) *batcher {
+	return &batcher{
+		batches: map[string]*batch{},
+		flush:   f,
+	}
+}
+
+// Append adds the given event to the function's current batch, starting a run
+// immediately if the batch is full.  The first event in a batch starts the batch's
+// timeout.
+func (b *batcher) Append(ctx context.Context, fn function.Function, cfg inngest.EventBatch, evt event.Event) error {
+	timeout, err := str2duration.ParseDuration(cfg.Timeout)
+	if err != nil {
+		return fmt.Errorf("error parsing batch timeout: %w", err)
+	}
+
+	key, err := batchKey(ctx, fn, cfg, evt)
+	if err != nil {
+		return err
+	}
+
+	b.lock.Lock()
+	pending, ok := b.batches[key]
+	if !ok {
+		pending = b.add(ctx, key, fn, timeout)
+	}
+	pending.events = append(pending.events, evt)
+
+	if len(pending.events) < cfg.MaxSize {
+		b.lock.Unlock()
+		return nil
+	}
+
+	// The batch is full, so start a run with the batch immediately.
+	pending.timer.Stop()
+	delete(b.batches, key)
+	b.lock.Unlock()
+
+	if err := b.flush(ctx, fn, pending.events); err != nil {
+		// Retry the batch without the given event, which is retried when its
+		// message is redelivered.
+		b.retry(pending, key, pending.events[:len(pending.events)-1])
+		return err
+	}
+	return nil
+}
+
+// add creates a pending batch with the given key, starting a run with the batch
+// once the timeout elapses.  This must be called with the lock held.
+func (b *batcher) add(ctx context.Context, key string, fn function.Function, timeout time.Duration) *batch {
+	pending := &batch{ctx: ctx, fn: fn, timeout: timeout}
+	b.batches[key] = pending
+	pending.timer = time.AfterFunc(timeout, func() {
+		evts := b.take(key, pending)
+		if len(evts) == 0 {
+			return
+		}
+		if err := b.flush(pending.ctx, pending.fn, evts); err != nil {
+			logger.From(pending.ctx).Error().
+				Err(err).
+				Str("function", pending.fn.ID).
+				Msg("error initializing batched fn")
+			b.retry(pending, key, evts)
+		}
+	})
+	return pending
+}
+
+// retry adds the events of a batch which failed to start a run to the front of
+// the key's pending batch, so that they're included in the batch's next run.
+func (b *batcher) retry(p *batch, key string, evts []event.Event) {
+	if len(evts) == 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	pending, ok := b.batches[key]
+	if !ok {
+		pending = b.add(p.ctx, key, p.fn, p.timeout)
+	}
+	pending.events = append(append([]event.Event{}, evts...), pending.events...)
+}
+
+// Flush starts runs for all pending batches without waiting for their timeouts.
+func (b *batcher) Flush(ctx context.Context) error {
+	b.lock.Lock()
+	pending := b.batches
+	b.batches = map[string]*batch{}
+	b.lock.Unlock()
+
+	var err error
+	for _, p := range pending {
+		p.timer.Stop()
+		if ferr := b.flush(ctx, p.fn, p.events); ferr != nil {
+			err = ferr
+		}
+	}
+	return err
+}
+
+// take removes the given batch if it's still pending, returning its events.
+func (b *batcher) take(key string, p *batch) []event.Event {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.batches[key] != p {
+		// This batch has already been flushed.
+		return nil
+	}
+	delete(b.batches, key)
+	return p.events
+}
+
+// batchKey returns the key used to accumulate the given event, evaluating the
+// batch's key expression using the event.
+func batchKey(ctx context.Context, fn function.Function, cfg inngest.EventBatch, evt event.Event) (string, error) {
+	key := fn.ID
+	if cfg.Key != nil {
+		val, _, err := expressions.Evaluate(ctx, *cfg.Key, map[string]interface{}{
+			"event": evt.Map(),
+		})
+		if err != nil {
+			return "", fmt.Errorf("error evaluating batch key: %w", err)
+		}
+		key = fmt.Sprintf("%s:%s", key, strconv.FormatUint(xxhash.Sum64String(fmt.Sprintf("%v", val)), 36))
+	}
+	return key, nil
+}
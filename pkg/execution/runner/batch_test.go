@@ -0,0 +1,176 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/stretchr/testify/require"
+)
+
+// flushed records every batch flushed by a batcher.
+type flushed struct {
+	lock    sync.Mutex
+	batches [][]event.Event
+	// failures is the number of flushes to fail before recording batches.
+	failures int
+}
+
+func (f *flushed) flush(ctx context.Context, fn function.Function, evts []event.Event) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		return fmt.Errorf("unable to start run")
+	}
+	f.batches = append(f.batches, evts)
+	return nil
+}
+
+func (f *flushed) len() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return len(f.batches)
+}
+
+func TestInitializeBatch(t *testing.T) {
+	ctx := context.Background()
+
+	fn := function.Function{
+		ID:   "batched",
+		Name: "batched",
+		Triggers: []function.Trigger{
+			{EventTrigger: &function.EventTrigger{Event: "test/user.created"}},
+		},
+		Steps: map[string]function.Step{
+			"step-1": {
+				ID:   "step-1",
+				Name: "step-1",
+				Path: "file://.",
+				Runtime: &inngest.RuntimeWrapper{
+					Runtime: inngest.RuntimeHTTP{URL: "http://localhost"},
+				},
+			},
+		},
+	}
+
+	evts := []event.Event{
+		{ID: "evt-1", Name: "test/user.created", Data: map[string]any{"n": 1}},
+		{ID: "evt-2", Name: "test/user.created", Data: map[string]any{"n": 2}},
+	}
+
+	sm := inmemory.NewStateManager()
+	p := &producer{}
+	id, err := InitializeBatch(ctx, fn, evts, sm, p)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(p.items))
+	require.Equal(t, "evt-1", id.Key)
+
+	s, err := sm.Load(ctx, id.RunID)
+	require.NoError(t, err)
+	require.Equal(t, evts[0].Map(), s.Event())
+	require.Equal(t, []map[string]any{evts[0].Map(), evts[1].Map()}, s.Events())
+	require.Equal(t, []string{"evt-1", "evt-2"}, s.Metadata().EventIDs)
+
+	runs, err := sm.(inmemory.InmemoryLoader).Runs(ctx, "evt-2")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(runs), "Runs must include every event in a batch")
+
+	_, err = InitializeBatch(ctx, fn, nil, sm, p)
+	require.Error(t, err)
+}
+
+func TestBatcher(t *testing.T) {
+	ctx := context.Background()
+	fn := function.Function{ID: "batched"}
+
+	evt := func(n int, account string) event.Event {
+		return event.Event{
+			ID:   fmt.Sprintf("evt-%d", n),
+			Name: "test/account.updated",
+			Data: map[string]any{"account_id": account},
+		}
+	}
+
+	t.Run("It starts a run once a batch is full", func(t *testing.T) {
+		f := &flushed{}
+		b := newBatcher(f.flush)
+		cfg := inngest.EventBatch{MaxSize: 3, Timeout: "1h"}
+
+		for i := 1; i <= 5; i++ {
+			require.NoError(t, b.Append(ctx, fn, cfg, evt(i, "a")))
+		}
+		require.Equal(t, 1, f.len())
+		require.Equal(t, []event.Event{evt(1, "a"), evt(2, "a"), evt(3, "a")}, f.batches[0])
+
+		// The remaining events are flushed on shutdown.
+		require.NoError(t, b.Flush(ctx))
+		require.Equal(t, 2, f.len())
+		require.Equal(t, []event.Event{evt(4, "a"), evt(5, "a")}, f.batches[1])
+	})
+
+	t.Run("It starts a run with a partial batch after the timeout", func(t *testing.T) {
+		f := &flushed{}
+		b := newBatcher(f.flush)
+		cfg := inngest.EventBatch{MaxSize: 10, Timeout: "50ms"}
+
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(1, "a")))
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(2, "a")))
+		require.Equal(t, 0, f.len())
+
+		require.Eventually(t, func() bool { return f.len() == 1 }, time.Second, 10*time.Millisecond)
+		require.Equal(t, []event.Event{evt(1, "a"), evt(2, "a")}, f.batches[0])
+
+		// Flushing doesn't start the timed out batch again.
+		require.NoError(t, b.Flush(ctx))
+		require.Equal(t, 1, f.len())
+	})
+
+	t.Run("It accumulates separate batches for each key", func(t *testing.T) {
+		f := &flushed{}
+		b := newBatcher(f.flush)
+		key := "event.data.account_id"
+		cfg := inngest.EventBatch{MaxSize: 2, Timeout: "1h", Key: &key}
+
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(1, "a")))
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(2, "b")))
+		require.Equal(t, 0, f.len())
+
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(3, "b")))
+		require.Equal(t, 1, f.len())
+		require.Equal(t, []event.Event{evt(2, "b"), evt(3, "b")}, f.batches[0])
+	})
+
+	t.Run("It retries timed out batches which fail to start a run", func(t *testing.T) {
+		f := &flushed{failures: 1}
+		b := newBatcher(f.flush)
+		cfg := inngest.EventBatch{MaxSize: 10, Timeout: "50ms"}
+
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(1, "a")))
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(2, "a")))
+
+		require.Eventually(t, func() bool { return f.len() == 1 }, time.Second, 10*time.Millisecond)
+		require.Equal(t, []event.Event{evt(1, "a"), evt(2, "a")}, f.batches[0])
+	})
+
+	t.Run("It returns errors starting full batches, keeping earlier events", func(t *testing.T) {
+		f := &flushed{failures: 1}
+		b := newBatcher(f.flush)
+		cfg := inngest.EventBatch{MaxSize: 2, Timeout: "1h"}
+
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(1, "a")))
+		require.Error(t, b.Append(ctx, fn, cfg, evt(2, "a")))
+		require.Equal(t, 0, f.len())
+
+		// The event which failed is appended again once redelivered.
+		require.NoError(t, b.Append(ctx, fn, cfg, evt(2, "a")))
+		require.Equal(t, 1, f.len())
+		require.Equal(t, []event.Event{evt(1, "a"), evt(2, "a")}, f.batches[0])
+	})
+}
@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+)
+
+// CancelRun manually cancels the given function run, recording the cancellation
+// within the run's history.
+func CancelRun(ctx context.Context, sm state.Manager, q queue.Producer, runID ulid.ULID) error {
+	run, err := sm.Load(ctx, runID)
+	if err != nil {
+		return fmt.Errorf("error loading run: %w", err)
+	}
+	err = sm.Cancel(ctx, run.Identifier(), state.HistoryFunctionCancelled{
+		Type: enums.CancellationTypeManual,
+	})
+	if err != nil {
+		return err
+	}
+	return InvokeFinished(ctx, sm, q, run.Identifier())
+}
+
+// CancelRuns manually cancels all running runs of the given function which
+// started within the given time range, inclusive of from and exclusive of to.
+// This returns the number of runs cancelled.
+//
+// The state store must implement state.RunLister.
+func CancelRuns(ctx context.Context, sm state.Manager, q queue.Producer, functionID uuid.UUID, from, to time.Time) (int, error) {
+	lister, ok := sm.(state.RunLister)
+	if !ok {
+		return 0, fmt.Errorf("the state store does not support listing runs")
+	}
+
+	ids, err := lister.RunningRuns(ctx, functionID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, id := range ids {
+		err := sm.Cancel(ctx, id, state.HistoryFunctionCancelled{
+			Type: enums.CancellationTypeManual,
+		})
+		switch err {
+		case nil:
+			n++
+			if err := InvokeFinished(ctx, sm, q, id); err != nil {
+				return n, fmt.Errorf("error finishing run %s: %w", id.RunID, err)
+			}
+		case state.ErrFunctionCancelled, state.ErrFunctionComplete, state.ErrFunctionFailed:
+			// The run finished after being listed.
+		default:
+			return n, fmt.Errorf("error cancelling run %s: %w", id.RunID, err)
+		}
+	}
+	return n, nil
+}
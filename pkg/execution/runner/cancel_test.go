@@ -0,0 +1,57 @@
+package runner
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/queue/inmemoryqueue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelRuns(t *testing.T) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+	q := inmemoryqueue.New()
+	wid := uuid.New()
+	from := time.Now().Add(-time.Second)
+
+	ids := []state.Identifier{}
+	for i := 0; i < 3; i++ {
+		id := state.Identifier{
+			WorkflowID: wid,
+			RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+		}
+		_, err := sm.New(ctx, state.Input{Identifier: id})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// Cancelling a single run records a manual cancellation.
+	err := CancelRun(ctx, sm, q, ids[0].RunID)
+	require.NoError(t, err)
+
+	history, err := sm.History(ctx, ids[0].RunID)
+	require.NoError(t, err)
+	require.Equal(t, enums.HistoryTypeFunctionCancelled, history[len(history)-1].Type)
+	cancelled, ok := history[len(history)-1].Data.(state.HistoryFunctionCancelled)
+	require.True(t, ok)
+	require.Equal(t, enums.CancellationTypeManual, cancelled.Type)
+
+	// Cancelling in bulk skips runs which are already cancelled.
+	n, err := CancelRuns(ctx, sm, q, wid, from, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	for _, id := range ids {
+		run, err := sm.Load(ctx, id.RunID)
+		require.NoError(t, err)
+		require.Equal(t, enums.RunStatusCancelled, run.Metadata().Status)
+	}
+}
@@ -0,0 +1,73 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializeConcurrency(t *testing.T) {
+	ctx := context.Background()
+
+	key := "event.data.account_id"
+	fn := function.Function{
+		ID:   "limited",
+		Name: "limited",
+		Triggers: []function.Trigger{
+			{EventTrigger: &function.EventTrigger{Event: "test/account.updated"}},
+		},
+		Concurrency: &inngest.Concurrency{Limit: 5},
+		Steps: map[string]function.Step{
+			"step-1": {
+				ID:   "step-1",
+				Name: "step-1",
+				Path: "file://.",
+				Runtime: &inngest.RuntimeWrapper{
+					Runtime: inngest.RuntimeHTTP{URL: "http://localhost"},
+				},
+			},
+		},
+	}
+
+	account := func(accountID string) event.Event {
+		return event.Event{
+			ID:   accountID,
+			Name: "test/account.updated",
+			Data: map[string]any{"account_id": accountID},
+		}
+	}
+
+	t.Run("It adds a function limit to the identifier", func(t *testing.T) {
+		p := &producer{}
+		a, err := Initialize(ctx, fn, account("a"), inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+		b, err := Initialize(ctx, fn, account("b"), inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+
+		require.NotNil(t, a.Concurrency)
+		require.Equal(t, 5, a.Concurrency.Limit)
+		require.Equal(t, a.Concurrency, b.Concurrency)
+		require.Equal(t, a.Concurrency, p.items[0].Identifier.Concurrency)
+	})
+
+	t.Run("It evaluates concurrency keys", func(t *testing.T) {
+		keyed := fn
+		keyed.Concurrency = &inngest.Concurrency{Limit: 1, Key: &key}
+
+		p := &producer{}
+		a, err := Initialize(ctx, keyed, account("a"), inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+		b, err := Initialize(ctx, keyed, account("b"), inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+		again, err := Initialize(ctx, keyed, account("a"), inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+
+		require.NotEqual(t, a.Concurrency.Key, b.Concurrency.Key)
+		require.Equal(t, a.Concurrency.Key, again.Concurrency.Key)
+	})
+}
@@ -0,0 +1,113 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/inngest"
+	inmemorydatastore "github.com/inngest/inngest/pkg/coredata/inmemory"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/queue/inmemoryqueue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/inngest/inngest/pkg/pubsub"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingQueue records the items enqueued to the queue.
+type recordingQueue struct {
+	queue.Queue
+	items []queue.Item
+}
+
+func (q *recordingQueue) Enqueue(ctx context.Context, item queue.Item, at time.Time) error {
+	q.items = append(q.items, item)
+	return nil
+}
+
+func TestDebounce(t *testing.T) {
+	ctx := context.Background()
+
+	key := "event.user.id"
+	fn := function.Function{
+		ID:   "debounced",
+		Name: "debounced",
+		Triggers: []function.Trigger{
+			{EventTrigger: &function.EventTrigger{Event: "user/profile.updated"}},
+		},
+		Debounce: &inngest.Debounce{Period: "10s", Key: &key},
+		Steps: map[string]function.Step{
+			"step-1": {
+				ID:   "step-1",
+				Name: "step-1",
+				Path: "file://.",
+				Runtime: &inngest.RuntimeWrapper{
+					Runtime: inngest.RuntimeHTTP{URL: "http://localhost"},
+				},
+			},
+		},
+	}
+
+	updated := func(id, userID string) event.Event {
+		return event.Event{
+			ID:   id,
+			Name: "user/profile.updated",
+			User: map[string]any{"id": userID},
+		}
+	}
+
+	t.Run("It only starts the latest debounce", func(t *testing.T) {
+		sm := inmemory.NewStateManager()
+		p := &producer{}
+		require.NoError(t, Debounce(ctx, fn, updated("evt-1", "a"), sm, p))
+		require.NoError(t, Debounce(ctx, fn, updated("evt-2", "a"), sm, p))
+		require.Equal(t, 2, len(p.items))
+
+		first := p.items[0].Payload.(queue.PayloadDebounce)
+		latest := p.items[1].Payload.(queue.PayloadDebounce)
+		require.Equal(t, queue.KindDebounce, p.items[1].Kind)
+		require.Equal(t, first.Key, latest.Key)
+
+		_, err := sm.ConsumeDebounce(ctx, first.Key, first.DebounceID)
+		require.ErrorIs(t, err, state.ErrDebounceNotFound)
+
+		d, err := sm.ConsumeDebounce(ctx, latest.Key, latest.DebounceID)
+		require.NoError(t, err)
+		require.Equal(t, "evt-2", d.Event.ID)
+		require.Equal(t, fn.ID, d.FunctionID)
+	})
+
+	t.Run("It debounces each key independently", func(t *testing.T) {
+		sm := inmemory.NewStateManager()
+		p := &producer{}
+		require.NoError(t, Debounce(ctx, fn, updated("evt-1", "a"), sm, p))
+		require.NoError(t, Debounce(ctx, fn, updated("evt-2", "b"), sm, p))
+
+		a := p.items[0].Payload.(queue.PayloadDebounce)
+		b := p.items[1].Payload.(queue.PayloadDebounce)
+		require.NotEqual(t, a.Key, b.Key)
+
+		_, err := sm.ConsumeDebounce(ctx, a.Key, a.DebounceID)
+		require.NoError(t, err)
+		_, err = sm.ConsumeDebounce(ctx, b.Key, b.DebounceID)
+		require.NoError(t, err)
+	})
+	t.Run("It drops duplicate events before debouncing", func(t *testing.T) {
+		el := &inmemorydatastore.MemoryExecutionLoader{}
+		require.NoError(t, el.SetFunctions(ctx, []*function.Function{&fn}))
+		q := &recordingQueue{Queue: inmemoryqueue.New()}
+		s := &svc{state: inmemory.NewStateManager(), queue: q, data: el}
+
+		byt, err := json.Marshal(updated("evt-1", "a"))
+		require.NoError(t, err)
+		msg := pubsub.Message{Name: event.EventReceivedName, Data: string(byt)}
+
+		require.NoError(t, s.handleMessage(ctx, msg))
+		require.NoError(t, s.handleMessage(ctx, msg))
+		require.Equal(t, 1, len(q.items), "duplicate events must not be debounced")
+	})
+}
@@ -0,0 +1,135 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializeIdempotency(t *testing.T) {
+	ctx := context.Background()
+
+	key := "{{ event.data.order_id }}"
+	fn := function.Function{
+		ID:          "idempotent",
+		Name:        "idempotent",
+		Idempotency: &key,
+		Triggers: []function.Trigger{
+			{EventTrigger: &function.EventTrigger{Event: "test/order.created"}},
+		},
+		Steps: map[string]function.Step{
+			"step-1": {
+				ID:   "step-1",
+				Name: "step-1",
+				Path: "file://.",
+				Runtime: &inngest.RuntimeWrapper{
+					Runtime: inngest.RuntimeHTTP{URL: "http://localhost"},
+				},
+			},
+		},
+	}
+
+	order := func(id, orderID string) event.Event {
+		return event.Event{
+			ID:   id,
+			Name: "test/order.created",
+			Data: map[string]any{"order_id": orderID},
+		}
+	}
+
+	t.Run("It uses the evaluated key as the identifier key", func(t *testing.T) {
+		p := &producer{}
+		id, err := Initialize(ctx, fn, order("evt-1", "order-1"), inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+		require.Equal(t, "order-1", id.Key)
+		require.Equal(t, 1, len(p.items))
+	})
+
+	t.Run("It skips duplicate keys and records the skipped run", func(t *testing.T) {
+		p := &producer{}
+		sm := inmemory.NewStateManager()
+
+		first, err := Initialize(ctx, fn, order("evt-1", "order-1"), sm, p)
+		require.NoError(t, err)
+
+		second, err := Initialize(ctx, fn, order("evt-2", "order-1"), sm, p)
+		require.ErrorIs(t, err, ErrFunctionSkipped)
+		require.NotNil(t, second)
+		require.NotEqual(t, first.RunID, second.RunID)
+		require.Equal(t, 1, len(p.items), "skipped runs must not be enqueued")
+
+		runs, err := sm.(inmemory.InmemoryLoader).Runs(ctx, "evt-2")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(runs))
+		require.Equal(t, enums.RunStatusSkipped, runs[0].Status)
+
+		history, err := sm.History(ctx, second.RunID)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(history))
+		require.Equal(t, enums.HistoryTypeFunctionSkipped, history[0].Type)
+		require.Equal(t, "order-1", history[0].Data.(state.HistoryFunctionSkipped).Key)
+	})
+
+	t.Run("It runs functions with different keys", func(t *testing.T) {
+		p := &producer{}
+		sm := inmemory.NewStateManager()
+
+		_, err := Initialize(ctx, fn, order("evt-1", "order-1"), sm, p)
+		require.NoError(t, err)
+		_, err = Initialize(ctx, fn, order("evt-2", "order-2"), sm, p)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(p.items))
+	})
+
+	t.Run("It uses the event ID when the key is empty", func(t *testing.T) {
+		p := &producer{}
+		sm := inmemory.NewStateManager()
+
+		first, err := Initialize(ctx, fn, order("evt-1", ""), sm, p)
+		require.NoError(t, err)
+		require.Equal(t, "evt-1", first.Key)
+		_, err = Initialize(ctx, fn, order("evt-2", ""), sm, p)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(p.items), "empty keys must not be shared")
+	})
+
+	t.Run("It allows keys after the idempotency period", func(t *testing.T) {
+		p := &producer{}
+		sm := inmemory.NewStateManager()
+
+		period := "10ms"
+		short := fn
+		short.IdempotencyPeriod = &period
+
+		_, err := Initialize(ctx, short, order("evt-1", "order-1"), sm, p)
+		require.NoError(t, err)
+		_, err = Initialize(ctx, short, order("evt-2", "order-1"), sm, p)
+		require.ErrorIs(t, err, ErrFunctionSkipped)
+
+		<-time.After(20 * time.Millisecond)
+		_, err = Initialize(ctx, short, order("evt-3", "order-1"), sm, p)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(p.items))
+	})
+	t.Run("It skips events which have already triggered a run", func(t *testing.T) {
+		p := &producer{}
+		sm := inmemory.NewStateManager()
+
+		plain := fn
+		plain.Idempotency = nil
+
+		_, err := Initialize(ctx, plain, order("evt-1", "order-1"), sm, p)
+		require.NoError(t, err)
+		_, err = Initialize(ctx, plain, order("evt-1", "order-1"), sm, p)
+		require.ErrorIs(t, err, state.ErrIdentifierExists)
+		require.Equal(t, 1, len(p.items), "duplicate events must not be enqueued")
+	})
+}
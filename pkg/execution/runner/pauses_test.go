@@ -0,0 +1,164 @@
+package runner
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/coredata"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/queue/inmemoryqueue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/inngest/inngest/pkg/pubsub"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// BenchmarkPauses benchmarks handling an event when many pauses wait for the
+// same event name, with expressions that match each pause's own run.
+func BenchmarkPauses(b *testing.B) {
+	for _, n := range []int{1000, 10000} {
+		b.Run(fmt.Sprintf("unindexed/%d", n), func(b *testing.B) {
+			benchmarkPauses(b, n, false)
+		})
+		b.Run(fmt.Sprintf("indexed/%d", n), func(b *testing.B) {
+			benchmarkPauses(b, n, true)
+		})
+	}
+}
+
+func benchmarkPauses(b *testing.B, n int, prepare bool) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+	s := &svc{state: sm, queue: inmemoryqueue.New()}
+
+	savePauses(b, sm, n, prepare)
+
+	// The event matches none of the pauses, so that each iteration does the
+	// same amount of work.
+	evt := event.Event{
+		Name: "test/pause",
+		Data: map[string]any{"id": "missing"},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := s.pauses(ctx, evt)
+		require.NoError(b, err)
+	}
+}
+
+func TestPausesIndexed(t *testing.T) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+	s := &svc{state: sm, queue: inmemoryqueue.New()}
+
+	pauses := savePauses(t, sm, 10, true)
+	for _, p := range pauses {
+		require.NotNil(t, p.MatchKey)
+	}
+
+	// Only the pause matching the event should be consumed.
+	evt := event.Event{
+		Name: "test/pause",
+		Data: map[string]any{"id": "run-3"},
+	}
+	err := s.pauses(ctx, evt)
+	require.NoError(t, err)
+
+	for n, p := range pauses {
+		_, err := sm.PauseByID(ctx, p.ID)
+		if n == 3 {
+			require.ErrorIs(t, err, state.ErrPauseNotFound)
+			continue
+		}
+		require.NoError(t, err)
+	}
+}
+
+// savePauses creates n runs, each waiting for an event with the same name
+// whose data must match the run's own ID.  If prepare is false the pauses are
+// saved without expression data, requiring state to be loaded to evaluate
+// each pause.
+func savePauses(t testing.TB, sm state.Manager, n int, prepare bool) []state.Pause {
+	ctx := context.Background()
+	evtName := "test/pause"
+	expr := "async.data.id == event.data.id"
+
+	pauses := []state.Pause{}
+	for i := 0; i < n; i++ {
+		id := state.Identifier{
+			WorkflowID: uuid.New(),
+			RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+		}
+		run, err := sm.New(ctx, state.Input{
+			Identifier: id,
+			EventData: map[string]any{
+				"name": "test/start",
+				"data": map[string]any{"id": fmt.Sprintf("run-%d", i)},
+			},
+		})
+		require.NoError(t, err)
+
+		p := state.Pause{
+			ID:         uuid.New(),
+			Identifier: id,
+			Outgoing:   inngest.TriggerName,
+			Incoming:   "step",
+			Expires:    state.Time(time.Now().Add(time.Hour)),
+			Event:      &evtName,
+			Expression: &expr,
+		}
+		if prepare {
+			err = p.PrepareExpression(ctx, state.EdgeExpressionData(ctx, run, p.Outgoing))
+			require.NoError(t, err)
+		}
+		err = sm.SavePause(ctx, p)
+		require.NoError(t, err)
+		pauses = append(pauses, p)
+	}
+	return pauses
+}
+
+// failingPauses is a state manager which fails to load pauses.
+type failingPauses struct {
+	state.Manager
+}
+
+func (failingPauses) PausesByEventMatch(ctx context.Context, workspaceID uuid.UUID, eventName string, keys []string) (state.PauseIterator, error) {
+	return nil, fmt.Errorf("pauses unavailable")
+}
+
+// failingFunctions is an execution loader which fails to load functions.
+type failingFunctions struct {
+	coredata.ExecutionLoader
+}
+
+func (failingFunctions) FunctionsByTrigger(ctx context.Context, eventName string) ([]function.Function, error) {
+	return nil, fmt.Errorf("functions unavailable")
+}
+
+func TestHandleMessageErrors(t *testing.T) {
+	ctx := context.Background()
+	s := &svc{
+		state: failingPauses{Manager: inmemory.NewStateManager()},
+		queue: inmemoryqueue.New(),
+		data:  failingFunctions{},
+	}
+
+	byt, err := json.Marshal(event.Event{ID: "evt-1", Name: "test/pause"})
+	require.NoError(t, err)
+
+	// Functions and pauses are handled concurrently, and both errors are
+	// returned.
+	err = s.handleMessage(ctx, pubsub.Message{Name: event.EventReceivedName, Data: string(byt)})
+	require.ErrorContains(t, err, "functions unavailable")
+	require.ErrorContains(t, err, "pauses unavailable")
+}
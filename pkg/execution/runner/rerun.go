@@ -0,0 +1,137 @@
+package runner
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/oklog/ulid/v2"
+)
+
+// RunTypeRerun is the run type of runs created by Rerun.
+const RunTypeRerun = "rerun"
+
+// Rerun creates a new run of the given run's function version using the original
+// run's events.  If fromStep is empty the new run starts from the trigger.
+// Otherwise, the outputs of every step which precedes fromStep within the
+// function's graph are copied to the new run and the run starts at fromStep.
+//
+// The new run records the given run as its OriginalRunID.
+func Rerun(ctx context.Context, sm state.Manager, q queue.Producer, runID ulid.ULID, fromStep string) (*state.Identifier, error) {
+	run, err := sm.Load(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading run: %w", err)
+	}
+
+	flow := run.Workflow()
+	edge := inngest.SourceEdge
+	steps := map[string]any{}
+
+	if fromStep != "" {
+		if edge, err = rerunEdge(flow, fromStep); err != nil {
+			return nil, err
+		}
+		outputs := run.Actions()
+		for _, stepID := range ancestors(flow, fromStep) {
+			if output, ok := outputs[stepID]; ok {
+				steps[stepID] = output
+			}
+		}
+	}
+
+	// Reruns use their own run ID as the key, as the original run already holds
+	// the original key.
+	newRunID := ulid.MustNew(ulid.Now(), rand.Reader)
+	id := state.Identifier{
+		WorkflowID:  run.Identifier().WorkflowID,
+		RunID:       newRunID,
+		Key:         newRunID.String(),
+		Concurrency: run.Identifier().Concurrency,
+	}
+
+	// Reruns aren't awaited by the run which invoked the original run, so they
+	// must not resume it.
+	runCtx := map[string]any{}
+	for k, v := range run.Metadata().Context {
+		if k != state.ContextKeyInvokePauseID {
+			runCtx[k] = v
+		}
+	}
+
+	runType := RunTypeRerun
+	input := state.Input{
+		Workflow:      flow,
+		Identifier:    id,
+		EventData:     run.Event(),
+		Events:        run.Events(),
+		EventIDs:      run.Metadata().EventIDs,
+		RunType:       &runType,
+		OriginalRunID: &runID,
+		Steps:         steps,
+		Context:       runCtx,
+	}
+	if len(input.Events) < 2 {
+		// Only batched runs store their events.
+		input.Events = nil
+	}
+
+	newRun, err := sm.New(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("error creating run state: %w", err)
+	}
+
+	if err := saveCancelPauses(ctx, sm, newRun, flow.Cancel); err != nil {
+		return &id, err
+	}
+	if err := enqueueTimeouts(ctx, q, id, flow.Timeouts); err != nil {
+		return &id, err
+	}
+
+	err = q.Enqueue(ctx, queue.Item{
+		Kind:        queue.KindEdge,
+		Identifier:  id,
+		Payload:     queue.PayloadEdge{Edge: edge},
+		Backoff:     queue.EdgeBackoff(flow, edge),
+		MaxDuration: queue.EdgeMaxDuration(flow, edge),
+	}, time.Now())
+	if err != nil {
+		return &id, fmt.Errorf("error enqueuing function: %w", err)
+	}
+
+	return &id, nil
+}
+
+// rerunEdge returns the edge used to start a rerun at the given step.
+func rerunEdge(flow inngest.Workflow, stepID string) (inngest.Edge, error) {
+	for _, e := range flow.Edges {
+		if e.Incoming == stepID {
+			return inngest.Edge{Outgoing: e.Outgoing, Incoming: e.Incoming}, nil
+		}
+	}
+	return inngest.Edge{}, fmt.Errorf("step not found: %s", stepID)
+}
+
+// ancestors returns the IDs of every step which precedes the given step within
+// the workflow's graph.
+func ancestors(flow inngest.Workflow, stepID string) []string {
+	seen := map[string]bool{}
+	queue := []string{stepID}
+	ids := []string{}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, e := range flow.Edges {
+			if e.Incoming != next || e.Outgoing == inngest.TriggerName || seen[e.Outgoing] {
+				continue
+			}
+			seen[e.Outgoing] = true
+			ids = append(ids, e.Outgoing)
+			queue = append(queue, e.Outgoing)
+		}
+	}
+	return ids
+}
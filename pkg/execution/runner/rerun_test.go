@@ -0,0 +1,126 @@
+package runner
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRerun(t *testing.T) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+
+	// The trigger runs "a", which runs both "b" and "d".  "b" runs "c".
+	timeout := "30s"
+	flow := inngest.Workflow{
+		ID:   "rerun",
+		Name: "rerun",
+		Steps: []inngest.Step{
+			{ID: "a", Name: "a"},
+			{ID: "b", Name: "b"},
+			{ID: "c", Name: "c", Timeout: &timeout},
+			{ID: "d", Name: "d"},
+		},
+		Edges: []inngest.Edge{
+			{Outgoing: inngest.TriggerName, Incoming: "a"},
+			{Outgoing: "a", Incoming: "b"},
+			{Outgoing: "b", Incoming: "c"},
+			{Outgoing: "a", Incoming: "d"},
+		},
+	}
+	evt := map[string]any{"name": "test/rerun", "data": map[string]any{"n": 1}}
+
+	id := state.Identifier{
+		WorkflowID: uuid.New(),
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+		Key:        "evt-1",
+	}
+	_, err := sm.New(ctx, state.Input{
+		Workflow:   flow,
+		Identifier: id,
+		EventData:  evt,
+		EventIDs:   []string{"evt-1"},
+		Context: map[string]any{
+			"some":                        "data",
+			state.ContextKeyInvokePauseID: uuid.New().String(),
+		},
+	})
+	require.NoError(t, err)
+	for _, step := range flow.Steps {
+		_, err := sm.SaveResponse(ctx, id, state.DriverResponse{
+			Step:   step,
+			Output: map[string]any{"step": step.ID},
+		}, 0)
+		require.NoError(t, err)
+	}
+
+	t.Run("It reruns the entire function", func(t *testing.T) {
+		p := &producer{}
+		rerunID, err := Rerun(ctx, sm, p, id.RunID, "")
+		require.NoError(t, err)
+		require.NotEqual(t, id.RunID, rerunID.RunID)
+		require.Equal(t, id.WorkflowID, rerunID.WorkflowID)
+
+		run, err := sm.Load(ctx, rerunID.RunID)
+		require.NoError(t, err)
+		require.Equal(t, evt, run.Event())
+		require.Empty(t, run.Actions())
+		require.Equal(t, []string{"evt-1"}, run.Metadata().EventIDs)
+		require.Equal(t, id.RunID, *run.Metadata().OriginalRunID)
+		require.Equal(t, RunTypeRerun, *run.Metadata().RunType)
+		// Reruns must not resume the run which invoked the original run.
+		require.Equal(t, map[string]any{"some": "data"}, run.Metadata().Context)
+
+		require.Equal(t, 1, len(p.items))
+		edge, err := queue.GetEdge(p.items[0])
+		require.NoError(t, err)
+		require.Equal(t, inngest.SourceEdge, *edge)
+	})
+
+	t.Run("It reruns from a step, copying the outputs of preceding steps", func(t *testing.T) {
+		p := &producer{}
+		rerunID, err := Rerun(ctx, sm, p, id.RunID, "c")
+		require.NoError(t, err)
+
+		run, err := sm.Load(ctx, rerunID.RunID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"a": map[string]any{"step": "a"},
+			"b": map[string]any{"step": "b"},
+		}, run.Actions())
+		require.Equal(t, id.RunID, *run.Metadata().OriginalRunID)
+
+		require.Equal(t, 1, len(p.items))
+		edge, err := queue.GetEdge(p.items[0])
+		require.NoError(t, err)
+		require.Equal(t, inngest.Edge{Outgoing: "b", Incoming: "c"}, *edge)
+		require.Equal(t, 30*time.Second, p.items[0].MaxDuration)
+	})
+
+	t.Run("It links reruns to the original run", func(t *testing.T) {
+		runs, err := sm.(inmemory.InmemoryLoader).Runs(ctx, "evt-1")
+		require.NoError(t, err)
+		require.Equal(t, 3, len(runs))
+		for _, m := range runs {
+			if m.Identifier.RunID == id.RunID {
+				require.Nil(t, m.OriginalRunID)
+				continue
+			}
+			require.Equal(t, id.RunID, *m.OriginalRunID)
+		}
+	})
+
+	t.Run("It fails with an unknown step", func(t *testing.T) {
+		_, err := Rerun(ctx, sm, &producer{}, id.RunID, "missing")
+		require.Error(t, err)
+	})
+}
@@ -5,15 +5,19 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"regexp"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/cespare/xxhash/v2"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
 	"github.com/inngest/inngest/inngest"
 	"github.com/inngest/inngest/pkg/config"
 	"github.com/inngest/inngest/pkg/coredata"
 	inmemorydatastore "github.com/inngest/inngest/pkg/coredata/inmemory"
+	"github.com/inngest/inngest/pkg/enums"
 	"github.com/inngest/inngest/pkg/event"
 	"github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/state"
@@ -21,15 +25,40 @@ import (
 	"github.com/inngest/inngest/pkg/expressions"
 	"github.com/inngest/inngest/pkg/function"
 	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/metrics"
 	"github.com/inngest/inngest/pkg/pubsub"
 	"github.com/inngest/inngest/pkg/service"
 	"github.com/oklog/ulid/v2"
 	"github.com/robfig/cron/v3"
+	"github.com/uber-go/tally/v4"
 	"github.com/xhit/go-str2duration/v2"
 )
 
 const (
 	CancelTimeout = (24 * time.Hour) * 365
+
+	// IdempotencyTTL is the default period for which a function's idempotency
+	// keys are held.
+	IdempotencyTTL = 24 * time.Hour
+
+	// EventDedupeTTL is the period for which received event IDs are recorded,
+	// dropping any duplicate events received within the period.
+	EventDedupeTTL = 24 * time.Hour
+
+	// InvokeEventName is the name of the event which triggers function runs
+	// started by another function via enums.OpcodeInvokeFunction.
+	InvokeEventName = "inngest/function.invoked"
+)
+
+var (
+	// templateExpr matches expressions within templates, eg. "{{ event.data.id }}".
+	templateExpr = regexp.MustCompile(`{{\s*(.+?)\s*}}`)
+)
+
+var (
+	// ErrFunctionSkipped is returned when initializing a function run which
+	// is skipped, eg. as a run with the same idempotency key already exists.
+	ErrFunctionSkipped = fmt.Errorf("function run skipped")
 )
 
 type Opt func(s *svc)
@@ -43,6 +72,15 @@ type Runner interface {
 	History(ctx context.Context, id state.Identifier) ([]state.History, error)
 	Runs(ctx context.Context, eventId string) ([]state.Metadata, error)
 	Events(ctx context.Context, eventId string) ([]event.Event, error)
+
+	// CancelRun manually cancels the given function run.
+	CancelRun(ctx context.Context, runID ulid.ULID) error
+	// CancelRuns manually cancels all running runs of the given function which
+	// started within the given time range, returning the number of cancelled runs.
+	CancelRuns(ctx context.Context, functionID uuid.UUID, from, to time.Time) (int, error)
+	// Rerun creates a new run of the given function run, optionally starting
+	// from the given step.
+	Rerun(ctx context.Context, runID ulid.ULID, fromStep string) (*state.Identifier, error)
 }
 
 func WithExecutionLoader(l coredata.ExecutionLoader) func(s *svc) {
@@ -64,7 +102,11 @@ func WithStateManager(sm state.Manager) func(s *svc) {
 }
 
 func NewService(c config.Config, opts ...Opt) Runner {
-	svc := &svc{config: c}
+	svc := &svc{
+		config:  c,
+		metrics: metrics.Scope().SubScope("runner"),
+	}
+	svc.batcher = newBatcher(svc.initializeBatch)
 	for _, o := range opts {
 		o(svc)
 	}
@@ -85,7 +127,11 @@ type svc struct {
 	queue queue.Queue
 	// cronmanager allows the creation of new scheduled functions.
 	cronmanager *cron.Cron
-	em          *event.Manager
+	// batcher accumulates events for functions with batch triggers.
+	batcher *batcher
+	em      *event.Manager
+	// metrics reports the number of initialized and skipped runs.
+	metrics tally.Scope
 }
 
 func (s svc) Name() string {
@@ -152,6 +198,11 @@ func (s *svc) Run(ctx context.Context) error {
 }
 
 func (s *svc) Stop(ctx context.Context) error {
+	// Start runs for any pending batches, as they're only held in memory.
+	if err := s.batcher.Flush(ctx); err != nil {
+		logger.From(ctx).Error().Err(err).Msg("error flushing batches")
+	}
+
 	cronCtx := s.cronmanager.Stop()
 	select {
 	case <-cronCtx.Done():
@@ -220,6 +271,18 @@ func (s *svc) Runs(ctx context.Context, eventId string) ([]state.Metadata, error
 	return s.state.(inmemory.InmemoryLoader).Runs(ctx, eventId)
 }
 
+func (s *svc) CancelRun(ctx context.Context, runID ulid.ULID) error {
+	return CancelRun(ctx, s.state, s.queue, runID)
+}
+
+func (s *svc) CancelRuns(ctx context.Context, functionID uuid.UUID, from, to time.Time) (int, error) {
+	return CancelRuns(ctx, s.state, s.queue, functionID, from, to)
+}
+
+func (s *svc) Rerun(ctx context.Context, runID ulid.ULID, fromStep string) (*state.Identifier, error) {
+	return Rerun(ctx, s.state, s.queue, runID, fromStep)
+}
+
 func (s *svc) Events(ctx context.Context, eventId string) ([]event.Event, error) {
 	if eventId != "" {
 		evt := s.em.EventById(eventId)
@@ -234,7 +297,7 @@ func (s *svc) Events(ctx context.Context, eventId string) ([]event.Event, error)
 }
 
 func (s *svc) handleMessage(ctx context.Context, m pubsub.Message) error {
-	if m.Name != "event/event.received" {
+	if m.Name != event.EventReceivedName {
 		return fmt.Errorf("unknown event type: %s", m.Name)
 	}
 
@@ -258,7 +321,22 @@ func (s *svc) handleMessage(ctx context.Context, m pubsub.Message) error {
 
 	l.Info().Msg("received message")
 
+	// Drop events which have already been received, eg. events re-sent by a
+	// retried step, before they're debounced or batched.
+	recorder, ok := s.state.(state.EventRecorder)
+	if ok && evt.ID != "" {
+		added, err := recorder.RecordEvent(ctx, evt.ID, EventDedupeTTL)
+		if err != nil {
+			return err
+		}
+		if !added {
+			l.Info().Msg("skipping duplicate event")
+			return nil
+		}
+	}
+
 	var errs error
+	errLock := &sync.Mutex{}
 	wg := &sync.WaitGroup{}
 
 	// Trigger both new functions and pauses.
@@ -267,7 +345,9 @@ func (s *svc) handleMessage(ctx context.Context, m pubsub.Message) error {
 		defer wg.Done()
 		if err := s.functions(ctx, *evt); err != nil {
 			l.Error().Err(err).Msg("error scheduling functions")
+			errLock.Lock()
 			errs = multierror.Append(errs, err)
+			errLock.Unlock()
 		}
 	}()
 
@@ -276,10 +356,21 @@ func (s *svc) handleMessage(ctx context.Context, m pubsub.Message) error {
 		defer wg.Done()
 		if err := s.pauses(ctx, *evt); err != nil {
 			l.Error().Err(err).Msg("error consuming pauses")
+			errLock.Lock()
 			errs = multierror.Append(errs, err)
+			errLock.Unlock()
 		}
 	}()
 
+	wg.Wait()
+
+	if errs != nil && ok && evt.ID != "" {
+		// Allow the event to be processed when it's redelivered.
+		if err := recorder.ForgetEvent(ctx, evt.ID); err != nil {
+			l.Error().Err(err).Msg("error forgetting event")
+		}
+	}
+
 	return errs
 }
 
@@ -300,6 +391,7 @@ func (s *svc) functions(ctx context.Context, evt event.Event) error {
 	evtMap := evt.Map()
 
 	var errs error
+	errLock := &sync.Mutex{}
 	wg := &sync.WaitGroup{}
 	for _, fn := range fns {
 		// We want to initialize each function concurrently;  some of these
@@ -322,7 +414,9 @@ func (s *svc) functions(ctx context.Context, evt event.Event) error {
 						"event": evtMap,
 					})
 					if evalerr != nil {
+						errLock.Lock()
 						errs = multierror.Append(errs, evalerr)
+						errLock.Unlock()
 						continue
 					}
 					if !ok {
@@ -331,6 +425,36 @@ func (s *svc) functions(ctx context.Context, evt event.Event) error {
 					}
 				}
 
+				if copied.Debounce != nil {
+					// Push back the function's pending run, which starts with
+					// the last event once the debounce period elapses.
+					if err := Debounce(ctx, copied, evt, s.state, s.queue); err != nil {
+						logger.From(ctx).Error().
+							Err(err).
+							Str("function", copied.ID).
+							Msg("error debouncing fn")
+						errLock.Lock()
+						errs = multierror.Append(errs, err)
+						errLock.Unlock()
+					}
+					return
+				}
+
+				if t.Batch != nil {
+					// Add this event to the function's batch, which starts a
+					// run with every event in the batch once full.
+					if err := s.batcher.Append(ctx, copied, *t.Batch, evt); err != nil {
+						logger.From(ctx).Error().
+							Err(err).
+							Str("function", copied.ID).
+							Msg("error batching event")
+						errLock.Lock()
+						errs = multierror.Append(errs, err)
+						errLock.Unlock()
+					}
+					return
+				}
+
 				// Initialize this function for this event only once;  we don't
 				// want multiple matching triggers to run the function more than once.
 				err := s.initialize(ctx, copied, evt)
@@ -339,7 +463,9 @@ func (s *svc) functions(ctx context.Context, evt event.Event) error {
 						Err(err).
 						Str("function", copied.ID).
 						Msg("error initializing fn")
+					errLock.Lock()
 					errs = multierror.Append(errs, err)
+					errLock.Unlock()
 				}
 				return
 			}
@@ -353,13 +479,17 @@ func (s *svc) functions(ctx context.Context, evt event.Event) error {
 // pauses searches for and triggers all pauses from this event.
 func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 	logger.From(ctx).Trace().Msg("querying for pauses")
+	evtMap := evt.Map()
+
+	// Only load pauses which may match the event's data, skipping pauses that
+	// are indexed by other values.
+	//
 	// TODO: Add workspace ID handling to the open source runner.
-	iter, err := s.state.PausesByEvent(ctx, uuid.UUID{}, evt.Name)
+	iter, err := s.state.PausesByEventMatch(ctx, uuid.UUID{}, evt.Name, state.PauseMatchKeys(evtMap))
 	if err != nil {
 		return fmt.Errorf("error finding event pauses: %w", err)
 	}
 
-	evtMap := evt.Map()
 	for iter.Next(ctx) {
 		pause := iter.Val(ctx)
 
@@ -375,9 +505,9 @@ func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 			Msg("handling pause")
 
 		if pause.Expression != nil {
-			data := pause.ExpressionData
+			var data map[string]any
 
-			if len(data) == 0 {
+			if pause.ExpressionData == nil {
 				// The pause had no expression data, so always load
 				// state for the function to retrieve expression data.
 				s, err := s.state.Load(ctx, pause.Identifier.RunID)
@@ -386,6 +516,13 @@ func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 				}
 				// Get expression data from the executor for the given run ID.
 				data = state.EdgeExpressionData(ctx, s, pause.Outgoing)
+			} else {
+				// Copy the stored data, which may be shared with the state
+				// store, before adding the event.
+				data = make(map[string]any, len(pause.ExpressionData)+1)
+				for k, v := range pause.ExpressionData {
+					data[k] = v
+				}
 			}
 
 			// Add the async event data to the expression
@@ -405,12 +542,13 @@ func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 		}
 
 		if pause.Cancel {
-			// This cancels the workflow, preventing future runs.
-			//
-			// XXX: When cancelling a workflow we should delete all other pauses
-			// for the same function run.  This should happen in the state store
-			// directly.
-			if err := s.state.Cancel(ctx, pause.Identifier); err != nil {
+			// This cancels the workflow, preventing future runs.  Cancelling
+			// deletes all pauses for the run within the state store, including
+			// this pause, so there's nothing left to resume.
+			if err := s.state.Cancel(ctx, pause.Identifier, state.HistoryFunctionCancelled{
+				Type: enums.CancellationTypeEvent,
+				Data: evtMap,
+			}); err != nil {
 				switch err {
 				case state.ErrFunctionCancelled, state.ErrFunctionComplete, state.ErrFunctionFailed:
 					// We can safely ignore these errors.
@@ -419,6 +557,10 @@ func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 					return err
 				}
 			}
+			if err := InvokeFinished(ctx, s.state, s.queue, pause.Identifier); err != nil {
+				return err
+			}
+			continue
 		}
 
 		if pause.OnTimeout {
@@ -429,16 +571,7 @@ func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 			}
 		}
 
-		logger.From(ctx).Debug().
-			Str("pause_id", pause.ID.String()).
-			Str("run_id", pause.Identifier.RunID.String()).
-			Msg("leasing pause")
-
-		// Lease this pause so that only this thread can schedule the execution.
-		//
-		// If we don't do this, there's a chance that two concurrent runners
-		// attempt to enqueue the next step of the workflow.
-		err := s.state.LeasePause(ctx, pause.ID)
+		err := ResumePause(ctx, s.state, s.queue, *pause, evtMap)
 		if err == state.ErrPauseLeased {
 			// Ignore;  this is being handled by another runner.
 			continue
@@ -446,36 +579,6 @@ func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 		if err != nil {
 			return err
 		}
-
-		logger.From(ctx).Info().
-			Str("pause_id", pause.ID.String()).
-			Str("run_id", pause.Identifier.RunID.String()).
-			Msg("resuming function")
-
-		// Schedule an execution from the pause's entrypoint.
-		if err := s.queue.Enqueue(
-			ctx,
-			queue.Item{
-				Kind:       queue.KindEdge,
-				Identifier: pause.Identifier,
-				Payload: queue.PayloadEdge{
-					Edge: inngest.Edge{
-						Incoming: pause.Incoming,
-					},
-				},
-			},
-			time.Now(),
-		); err != nil {
-			return err
-		}
-
-		logger.From(ctx).Debug().
-			Str("pause_id", pause.ID.String()).
-			Str("run_id", pause.Identifier.RunID.String()).
-			Msg("consuming pause")
-		if err := s.state.ConsumePause(ctx, pause.ID, evtMap); err != nil {
-			return err
-		}
 	}
 
 	return nil
@@ -484,6 +587,25 @@ func (s *svc) pauses(ctx context.Context, evt event.Event) error {
 func (s *svc) initialize(ctx context.Context, fn function.Function, evt event.Event) error {
 	logger.From(ctx).Info().Str("function", fn.ID).Msg("initializing fn")
 	_, err := Initialize(ctx, fn, evt, s.state, s.queue)
+	return s.initialized(err)
+}
+
+func (s *svc) initializeBatch(ctx context.Context, fn function.Function, evts []event.Event) error {
+	logger.From(ctx).Info().Str("function", fn.ID).Int("len", len(evts)).Msg("initializing batched fn")
+	_, err := InitializeBatch(ctx, fn, evts, s.state, s.queue)
+	return s.initialized(err)
+}
+
+// initialized reports the outcome of initializing a function run, returning nil
+// if the run was skipped.
+func (s *svc) initialized(err error) error {
+	switch err {
+	case nil:
+		s.metrics.Counter("runs_initialized").Inc(1)
+	case ErrFunctionSkipped, state.ErrIdentifierExists:
+		s.metrics.Counter("runs_skipped").Inc(1)
+		return nil
+	}
 	return err
 }
 
@@ -494,6 +616,49 @@ func (s *svc) initialize(ctx context.Context, fn function.Function, evt event.Ev
 // This is a separate, exported function so that it can be used from this service
 // and also from eg. the run command.
 func Initialize(ctx context.Context, fn function.Function, evt event.Event, s state.Manager, q queue.Producer) (*state.Identifier, error) {
+	return InitializeBatch(ctx, fn, []event.Event{evt}, s, q)
+}
+
+// InitializeBatch creates a new function run for the given batch of events.  The
+// first event in the batch is used as the run's root event, and is used to evaluate
+// the function's idempotency, throttle and concurrency keys.
+func InitializeBatch(ctx context.Context, fn function.Function, evts []event.Event, s state.Manager, q queue.Producer) (*state.Identifier, error) {
+	return initializeRun(ctx, fn, evts, nil, s, q)
+}
+
+// Invoke creates a new function run for the given event on behalf of another
+// function's run, which awaits this run's result via the given pause.  The
+// pause's ID is stored within the new run's metadata, such that the pause can
+// be resumed once the run finishes.
+func Invoke(ctx context.Context, fn function.Function, evt event.Event, pauseID uuid.UUID, s state.Manager, q queue.Producer) (*state.Identifier, error) {
+	return initializeRun(ctx, fn, []event.Event{evt}, map[string]any{
+		state.ContextKeyInvokePauseID: pauseID.String(),
+	}, s, q)
+}
+
+// InvokeFinished enqueues a job to resume the run which invoked the given run, if
+// the run was started by another function via enums.OpcodeInvokeFunction.  This
+// must be called each time a run completes, fails, or is cancelled.
+func InvokeFinished(ctx context.Context, s state.Manager, q queue.Producer, id state.Identifier) error {
+	run, err := s.Load(ctx, id.RunID)
+	if err != nil {
+		return err
+	}
+	if _, ok := run.Metadata().InvokePauseID(); !ok {
+		return nil
+	}
+	return q.Enqueue(ctx, queue.Item{
+		Kind:       queue.KindInvokeFinished,
+		Identifier: id,
+	}, time.Now())
+}
+
+func initializeRun(ctx context.Context, fn function.Function, evts []event.Event, runCtx map[string]any, s state.Manager, q queue.Producer) (*state.Identifier, error) {
+	if len(evts) == 0 {
+		return nil, fmt.Errorf("no events to initialize function with")
+	}
+	evt := evts[0]
+
 	// XXX: This could/should be memoized.
 	flow, err := fn.Workflow(ctx)
 	if err != nil {
@@ -515,47 +680,393 @@ func Initialize(ctx context.Context, fn function.Function, evt event.Event, s st
 		Key:        evt.ID,
 	}
 
-	if _, err := s.New(ctx, state.Input{
+	if flow.Concurrency != nil {
+		// Store the evaluated concurrency limit within the identifier, so that
+		// it's available to the queue when leasing each of the run's steps.
+		id.Concurrency, err = concurrency(ctx, flow.UUID, *flow.Concurrency, evt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	input := state.Input{
 		Workflow:   *flow,
 		Identifier: id,
 		EventData:  evt.Map(),
-	}); err != nil {
+		EventIDs:   make([]string, len(evts)),
+		Context:    runCtx,
+	}
+	for n, e := range evts {
+		input.EventIDs[n] = e.ID
+	}
+	if len(evts) > 1 {
+		input.Events = make([]map[string]any, len(evts))
+		for n, e := range evts {
+			input.Events[n] = e.Map()
+		}
+	}
+
+	if fn.Idempotency != nil {
+		// Use the evaluated idempotency key as the identifier's key, ensuring
+		// that the function runs at most once per key within the TTL.
+		input.Identifier.Key, input.IdempotencyTTL, err = idempotency(ctx, fn, evt)
+		if err != nil {
+			return nil, err
+		}
+		id = input.Identifier
+	}
+
+	run, err := s.New(ctx, input)
+	if err != nil {
+		if err == state.ErrIdentifierExists && fn.Idempotency != nil {
+			// This is a duplicate run for the idempotency key.  Record the run
+			// as skipped so that it's visible in history.
+			input.Skipped = true
+			if _, err := s.New(ctx, input); err != nil {
+				return nil, fmt.Errorf("error creating skipped run state: %w", err)
+			}
+			logger.From(ctx).Info().
+				Str("function", fn.ID).
+				Str("key", id.Key).
+				Msg("skipping function run with existing idempotency key")
+			return &id, ErrFunctionSkipped
+		}
+		if err == state.ErrIdentifierExists {
+			// The event has already triggered this function, eg. as an invoke
+			// step was retried.
+			logger.From(ctx).Info().
+				Str("function", fn.ID).
+				Str("key", id.Key).
+				Msg("skipping function run for duplicate event")
+			return &id, state.ErrIdentifierExists
+		}
 		return nil, fmt.Errorf("error creating run state: %w", err)
 	}
 
 	// Set any cancellation pauses immediately
-	for _, c := range fn.Cancel {
-		pauseID := uuid.New()
+	if err := saveCancelPauses(ctx, s, run, flow.Cancel); err != nil {
+		return &id, err
+	}
+
+	if err := enqueueTimeouts(ctx, q, id, flow.Timeouts); err != nil {
+		return &id, err
+	}
+
+	item := queue.Item{
+		Kind:       queue.KindEdge,
+		Identifier: id,
+		Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
+	}
+
+	// Idempotency is represented as a throttle with a count of 1, though it's
+	// enforced via the identifier's key within the state store.  Duplicate
+	// events must not delay new runs, so only add throttling for explicit
+	// throttle configuration.
+	if flow.Throttle != nil && fn.Idempotency == nil {
+		item.Throttle, err = throttle(ctx, flow.UUID, *flow.Throttle, evt)
+		if err != nil {
+			return &id, err
+		}
+	}
+
+	// Enqueue running this from the source.
+	err = q.Enqueue(ctx, item, time.Now())
+	if err != nil {
+		return &id, fmt.Errorf("error enqueuing function: %w", err)
+	}
+
+	return &id, nil
+}
+
+// ResumePause resumes the run awaiting the given pause, enqueueing the pause's
+// incoming step and consuming the pause with the given data.  The data is stored
+// as the output of the pause's DataKey step, if set.
+//
+// This returns state.ErrPauseLeased if the pause is being resumed elsewhere.
+func ResumePause(ctx context.Context, s state.Manager, q queue.Producer, pause state.Pause, data map[string]any) error {
+	logger.From(ctx).Debug().
+		Str("pause_id", pause.ID.String()).
+		Str("run_id", pause.Identifier.RunID.String()).
+		Msg("leasing pause")
+
+	// Lease this pause so that only this thread can schedule the execution.
+	//
+	// If we don't do this, there's a chance that two concurrent runners
+	// attempt to enqueue the next step of the workflow.
+	if err := s.LeasePause(ctx, pause.ID); err != nil {
+		return err
+	}
+
+	logger.From(ctx).Info().
+		Str("pause_id", pause.ID.String()).
+		Str("run_id", pause.Identifier.RunID.String()).
+		Msg("resuming function")
+
+	run, err := s.Load(ctx, pause.Identifier.RunID)
+	if err != nil {
+		return err
+	}
+
+	// Schedule an execution from the pause's entrypoint.
+	edge := inngest.Edge{Incoming: pause.Incoming}
+	if err := q.Enqueue(
+		ctx,
+		queue.Item{
+			Kind:        queue.KindEdge,
+			Identifier:  pause.Identifier,
+			Payload:     queue.PayloadEdge{Edge: edge},
+			Backoff:     queue.EdgeBackoff(run.Workflow(), edge),
+			MaxDuration: queue.EdgeMaxDuration(run.Workflow(), edge),
+		},
+		time.Now(),
+	); err != nil {
+		return err
+	}
+
+	logger.From(ctx).Debug().
+		Str("pause_id", pause.ID.String()).
+		Str("run_id", pause.Identifier.RunID.String()).
+		Msg("consuming pause")
+	return s.ConsumePause(ctx, pause.ID, data)
+}
+
+// saveCancelPauses saves a pause for each of the run's cancellation triggers.
+func saveCancelPauses(ctx context.Context, s state.Manager, run state.State, cancels []inngest.Cancel) error {
+	for _, c := range cancels {
+		c := c
 		expires := time.Now().Add(CancelTimeout)
 		if c.Timeout != nil {
+			// The run has already been created, so use the default timeout
+			// rather than failing and leaving the run without any steps.
 			dur, err := str2duration.ParseDuration(*c.Timeout)
 			if err != nil {
-				return &id, fmt.Errorf("error parsing cancel duration: %w", err)
+				logger.From(ctx).Warn().Err(err).Str("timeout", *c.Timeout).Msg("error parsing cancel duration")
+			} else {
+				expires = time.Now().Add(dur)
 			}
-			expires = time.Now().Add(dur)
 		}
-		err = s.SavePause(ctx, state.Pause{
-			ID:         pauseID,
-			Identifier: id,
+		pause := state.Pause{
+			ID:         uuid.New(),
+			Identifier: run.Identifier(),
 			Expires:    state.Time(expires),
 			Event:      &c.Event,
 			Expression: c.If,
 			Cancel:     true,
+		}
+		if err := pause.PrepareExpression(ctx, state.EdgeExpressionData(ctx, run, "")); err != nil {
+			// Save the pause regardless;  the runner loads state to evaluate
+			// unprepared expressions.
+			logger.From(ctx).Warn().Err(err).Msg("error preparing cancellation expression")
+		}
+		if err := s.SavePause(ctx, pause); err != nil {
+			return fmt.Errorf("error saving pause: %w", err)
+		}
+	}
+	return nil
+}
+
+// enqueueTimeouts enqueues an item for each of the function's timeouts, which
+// cancels the run if it exceeds the timeout.
+//
+// The items share the function's queue partition, so they fire late if the
+// partition is paused or backlogged;  see inngest.Timeouts.
+func enqueueTimeouts(ctx context.Context, q queue.Producer, id state.Identifier, t *inngest.Timeouts) error {
+	if t == nil {
+		return nil
+	}
+
+	// Timeouts must run regardless of the function's concurrency limits, as
+	// the start timeout exists to end runs stuck behind these limits.
+	id.Concurrency = nil
+
+	timeouts := []struct {
+		timeout string
+		dur     *string
+	}{
+		{queue.TimeoutRun, t.Run},
+		{queue.TimeoutStart, t.Start},
+	}
+	for _, to := range timeouts {
+		timeout, dur := to.timeout, to.dur
+		if dur == nil {
+			continue
+		}
+		d, err := str2duration.ParseDuration(*dur)
+		if err != nil {
+			return fmt.Errorf("error parsing %s timeout: %w", timeout, err)
+		}
+		err = q.Enqueue(ctx, queue.Item{
+			Kind:       queue.KindTimeout,
+			Identifier: id,
+			Payload: queue.PayloadTimeout{
+				Timeout:  timeout,
+				Duration: *dur,
+			},
+		}, time.Now().Add(d))
+		if err != nil {
+			return fmt.Errorf("error enqueueing %s timeout: %w", timeout, err)
+		}
+	}
+	return nil
+}
+
+// Debounce delays running the given function until the function's debounce
+// period elapses without any newer events for the same debounce key.  Each call
+// replaces the key's pending debounce with the given event and enqueues a debounce
+// item for the end of the period;  only the item for the latest debounce starts
+// the run.
+func Debounce(ctx context.Context, fn function.Function, evt event.Event, s state.Manager, q queue.Producer) error {
+	flow, err := fn.Workflow(ctx)
+	if err != nil {
+		return err
+	}
+	if flow.Debounce == nil {
+		return fmt.Errorf("function has no debounce configuration")
+	}
+
+	zero := uuid.UUID{}
+	if bytes.Equal(flow.UUID[:], zero[:]) {
+		flow.UUID = function.DeterministicUUID(fn)
+	}
+
+	period, err := str2duration.ParseDuration(flow.Debounce.Period)
+	if err != nil {
+		return fmt.Errorf("error parsing debounce period: %w", err)
+	}
+
+	key := flow.UUID.String()
+	if flow.Debounce.Key != nil {
+		val, _, err := expressions.Evaluate(ctx, *flow.Debounce.Key, map[string]interface{}{
+			"event": evt.Map(),
 		})
 		if err != nil {
-			return &id, fmt.Errorf("error saving pause: %w", err)
+			return fmt.Errorf("error evaluating debounce key: %w", err)
 		}
+		key = fmt.Sprintf("%s:%s", key, strconv.FormatUint(xxhash.Sum64String(fmt.Sprintf("%v", val)), 36))
+	}
+
+	d := state.Debounce{
+		ID:         ulid.MustNew(ulid.Now(), rand.Reader),
+		Key:        key,
+		FunctionID: fn.ID,
+		Event:      evt,
+		Period:     period,
+	}
+	if err := s.SaveDebounce(ctx, d); err != nil {
+		return err
 	}
 
-	// Enqueue running this from the source.
 	err = q.Enqueue(ctx, queue.Item{
-		Kind:       queue.KindEdge,
-		Identifier: id,
-		Payload:    queue.PayloadEdge{Edge: inngest.SourceEdge},
-	}, time.Now())
+		Kind: queue.KindDebounce,
+		Identifier: state.Identifier{
+			WorkflowID: flow.UUID,
+			RunID:      d.ID,
+			Key:        d.ID.String(),
+		},
+		Payload: queue.PayloadDebounce{
+			Key:        d.Key,
+			DebounceID: d.ID,
+		},
+	}, time.Now().Add(period))
 	if err != nil {
-		return &id, fmt.Errorf("error enqueuing function: %w", err)
+		return fmt.Errorf("error enqueuing debounce: %w", err)
 	}
+	return nil
+}
 
-	return &id, nil
+// idempotency returns the idempotency key and TTL for a new function run,
+// evaluating the function's idempotency key template using the given event.
+// Keys which render empty fall back to the event ID, such that events missing
+// the key's data don't share a single key.
+func idempotency(ctx context.Context, fn function.Function, evt event.Event) (string, time.Duration, error) {
+	ttl := IdempotencyTTL
+	if fn.IdempotencyPeriod != nil {
+		var err error
+		ttl, err = str2duration.ParseDuration(*fn.IdempotencyPeriod)
+		if err != nil {
+			return "", 0, fmt.Errorf("error parsing idempotency period: %w", err)
+		}
+	}
+
+	key, err := interpolate(ctx, *fn.Idempotency, map[string]interface{}{
+		"event": evt.Map(),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("error evaluating idempotency key: %w", err)
+	}
+	if key == "" {
+		logger.From(ctx).Warn().
+			Str("function", fn.ID).
+			Str("event_id", evt.ID).
+			Msg("idempotency key is empty, using the event ID")
+		key = evt.ID
+	}
+	return key, ttl, nil
+}
+
+// concurrency creates the concurrency limit for a new function run, evaluating
+// the concurrency key expression using the given event.
+func concurrency(ctx context.Context, fnID uuid.UUID, c inngest.Concurrency, evt event.Event) (*state.Concurrency, error) {
+	key := fnID.String()
+	if c.Key != nil {
+		val, _, err := expressions.Evaluate(ctx, *c.Key, map[string]interface{}{
+			"event": evt.Map(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating concurrency key: %w", err)
+		}
+		key = fmt.Sprintf("%s:%s", key, strconv.FormatUint(xxhash.Sum64String(fmt.Sprintf("%v", val)), 36))
+	}
+
+	return &state.Concurrency{
+		Key:   key,
+		Limit: int(c.Limit),
+	}, nil
+}
+
+// throttle creates the queue throttle for a new function run, evaluating the
+// throttle's key template using the given event.
+func throttle(ctx context.Context, fnID uuid.UUID, t inngest.Throttle, evt event.Event) (*queue.Throttle, error) {
+	period, err := str2duration.ParseDuration(t.Period)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing throttle period: %w", err)
+	}
+
+	key := fnID.String()
+	if t.Key != nil {
+		val, err := interpolate(ctx, *t.Key, map[string]interface{}{
+			"event": evt.Map(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating throttle key: %w", err)
+		}
+		key = fmt.Sprintf("%s:%s", key, strconv.FormatUint(xxhash.Sum64String(val), 36))
+	}
+
+	return &queue.Throttle{
+		Key:   key,
+		Limit: int(t.Count),
+		// Round partial seconds up, so that runs are never throttled over a
+		// shorter period than configured.
+		Period: int((period + time.Second - 1) / time.Second),
+	}, nil
+}
+
+// interpolate renders the given template, replacing each "{{ expression }}"
+// with the result of evaluating the expression against the given data.
+func interpolate(ctx context.Context, tpl string, data map[string]interface{}) (string, error) {
+	var err error
+	out := templateExpr.ReplaceAllStringFunc(tpl, func(match string) string {
+		expr := templateExpr.FindStringSubmatch(match)[1]
+		val, _, evalerr := expressions.Evaluate(ctx, expr, data)
+		if evalerr != nil {
+			err = multierror.Append(err, evalerr)
+			return ""
+		}
+		if val == nil {
+			return ""
+		}
+		return fmt.Sprintf("%v", val)
+	})
+	return out, err
 }
@@ -0,0 +1,129 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/stretchr/testify/require"
+)
+
+// producer records every item enqueued.
+type producer struct {
+	items []queue.Item
+}
+
+func (p *producer) Enqueue(ctx context.Context, item queue.Item, at time.Time) error {
+	p.items = append(p.items, item)
+	return nil
+}
+
+func TestInitializeThrottle(t *testing.T) {
+	ctx := context.Background()
+
+	key := "{{ event.data.user.id }}"
+	fn := function.Function{
+		ID:   "throttled",
+		Name: "throttled",
+		Triggers: []function.Trigger{
+			{EventTrigger: &function.EventTrigger{Event: "test/user.created"}},
+		},
+		Throttle: &inngest.Throttle{
+			Count:  2,
+			Period: "1m",
+			Key:    &key,
+		},
+		Steps: map[string]function.Step{
+			"step-1": {
+				ID:   "step-1",
+				Name: "step-1",
+				Path: "file://.",
+				Runtime: &inngest.RuntimeWrapper{
+					Runtime: inngest.RuntimeHTTP{URL: "http://localhost"},
+				},
+			},
+		},
+	}
+
+	initialize := func(t *testing.T, p *producer, userID string) queue.Item {
+		_, err := Initialize(ctx, fn, event.Event{
+			ID:   userID + time.Now().String(),
+			Name: "test/user.created",
+			Data: map[string]any{"user": map[string]any{"id": userID}},
+		}, inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+		return p.items[len(p.items)-1]
+	}
+
+	t.Run("It adds the throttle to new runs", func(t *testing.T) {
+		p := &producer{}
+		item := initialize(t, p, "a")
+		require.NotNil(t, item.Throttle)
+		require.Equal(t, 2, item.Throttle.Limit)
+		require.Equal(t, 60, item.Throttle.Period)
+	})
+
+	t.Run("It rounds partial second periods up", func(t *testing.T) {
+		partial := fn
+		partial.Throttle = &inngest.Throttle{Count: 2, Period: "1.5s"}
+		p := &producer{}
+		_, err := Initialize(ctx, partial, event.Event{
+			ID:   "partial",
+			Name: "test/user.created",
+		}, inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(p.items))
+		require.Equal(t, 2, p.items[0].Throttle.Period)
+	})
+
+	t.Run("It evaluates keys using event data", func(t *testing.T) {
+		p := &producer{}
+		a := initialize(t, p, "a")
+		b := initialize(t, p, "b")
+		again := initialize(t, p, "a")
+		require.NotEqual(t, a.Throttle.Key, b.Throttle.Key)
+		require.Equal(t, a.Throttle.Key, again.Throttle.Key)
+	})
+
+	t.Run("It does not throttle idempotent functions", func(t *testing.T) {
+		idempotent := fn
+		idempotent.Throttle = nil
+		idempotent.Idempotency = &key
+		p := &producer{}
+		_, err := Initialize(ctx, idempotent, event.Event{
+			ID:   "idempotent",
+			Name: "test/user.created",
+			Data: map[string]any{"user": map[string]any{"id": "a"}},
+		}, inmemory.NewStateManager(), p)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(p.items))
+		require.Equal(t, queue.KindEdge, p.items[0].Kind)
+		require.Nil(t, p.items[0].Throttle)
+	})
+}
+
+func TestInterpolate(t *testing.T) {
+	ctx := context.Background()
+	data := map[string]interface{}{
+		"event": map[string]interface{}{
+			"data": map[string]interface{}{"id": "123", "n": 5},
+		},
+	}
+
+	out, err := interpolate(ctx, "{{ event.data.id }}", data)
+	require.NoError(t, err)
+	require.Equal(t, "123", out)
+
+	out, err = interpolate(ctx, "user-{{event.data.id}}-{{ event.data.n }}", data)
+	require.NoError(t, err)
+	require.Equal(t, "user-123-5", out)
+
+	out, err = interpolate(ctx, "static", data)
+	require.NoError(t, err)
+	require.Equal(t, "static", out)
+}
@@ -0,0 +1,105 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/function"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitializeTimeouts(t *testing.T) {
+	ctx := context.Background()
+
+	run, start := "2h", "10m"
+	fn := function.Function{
+		ID:   "timeouts",
+		Name: "timeouts",
+		Triggers: []function.Trigger{
+			{EventTrigger: &function.EventTrigger{Event: "test/user.created"}},
+		},
+		Concurrency: &inngest.Concurrency{Limit: 1},
+		Timeouts:    &inngest.Timeouts{Run: &run, Start: &start},
+		Steps: map[string]function.Step{
+			"step-1": {
+				ID:   "step-1",
+				Name: "step-1",
+				Path: "file://.",
+				Runtime: &inngest.RuntimeWrapper{
+					Runtime: inngest.RuntimeHTTP{URL: "http://localhost"},
+				},
+			},
+		},
+	}
+
+	p := &producer{}
+	id, err := Initialize(ctx, fn, event.Event{ID: "evt-1", Name: "test/user.created"}, inmemory.NewStateManager(), p)
+	require.NoError(t, err)
+	require.NotNil(t, id.Concurrency)
+
+	require.Equal(t, 3, len(p.items))
+	timeouts := []queue.PayloadTimeout{}
+	for _, item := range p.items {
+		if item.Kind != queue.KindTimeout {
+			continue
+		}
+		// Timeouts aren't subject to the function's concurrency limits.
+		require.Nil(t, item.Identifier.Concurrency)
+		require.Equal(t, id.RunID, item.Identifier.RunID)
+		timeouts = append(timeouts, item.Payload.(queue.PayloadTimeout))
+	}
+	require.Equal(t, []queue.PayloadTimeout{
+		{Timeout: queue.TimeoutRun, Duration: "2h"},
+		{Timeout: queue.TimeoutStart, Duration: "10m"},
+	}, timeouts)
+}
+
+func TestInitializeInvalidCancel(t *testing.T) {
+	ctx := context.Background()
+	sm := inmemory.NewStateManager()
+
+	expr, timeout := "event.data.id ==", "nope"
+	fn := function.Function{
+		ID:   "cancel",
+		Name: "cancel",
+		Triggers: []function.Trigger{
+			{EventTrigger: &function.EventTrigger{Event: "test/user.created"}},
+		},
+		Cancel: []function.Cancel{
+			{Event: "test/user.deleted", If: &expr, Timeout: &timeout},
+		},
+		Steps: map[string]function.Step{
+			"step-1": {
+				ID:   "step-1",
+				Name: "step-1",
+				Path: "file://.",
+				Runtime: &inngest.RuntimeWrapper{
+					Runtime: inngest.RuntimeHTTP{URL: "http://localhost"},
+				},
+			},
+		},
+	}
+
+	// The run starts with an unprepared cancellation pause, rather than
+	// being created without any steps enqueued.
+	p := &producer{}
+	id, err := Initialize(ctx, fn, event.Event{ID: "evt-1", Name: "test/user.created"}, sm, p)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(p.items))
+
+	it, err := sm.PausesByEvent(ctx, uuid.UUID{}, "test/user.deleted")
+	require.NoError(t, err)
+	require.True(t, it.Next(ctx))
+	pause := it.Val(ctx)
+	require.Equal(t, id.RunID, pause.Identifier.RunID)
+	require.True(t, pause.Cancel)
+	require.Equal(t, expr, *pause.Expression)
+	require.Nil(t, pause.ExpressionData)
+	require.WithinDuration(t, time.Now().Add(CancelTimeout), time.Time(pause.Expires), time.Minute)
+}
@@ -0,0 +1,47 @@
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/inngest/inngest/pkg/event"
+	"github.com/oklog/ulid/v2"
+)
+
+// DebounceManager stores the pending debounced run for each debounce key.
+type DebounceManager interface {
+	// SaveDebounce stores the given debounce as the pending run for its key,
+	// replacing any pending debounce with the same key.
+	SaveDebounce(ctx context.Context, d Debounce) error
+
+	// ConsumeDebounce atomically removes and returns the pending debounce for the
+	// given key, if its ID matches the given ID.  This must return ErrDebounceNotFound
+	// if the key has no pending debounce or if the debounce has been replaced by a
+	// newer event.
+	ConsumeDebounce(ctx context.Context, key string, id ulid.ULID) (*Debounce, error)
+
+	// RestoreDebounce stores a consumed debounce as the pending run for its key
+	// again, eg. when the debounced run fails to start, such that the debounce
+	// can be consumed when retried.  If the key has a newer pending debounce, the
+	// newer debounce is kept.
+	RestoreDebounce(ctx context.Context, d Debounce) error
+}
+
+// Debounce is the pending run for a debounced function.  Each event received
+// for the same function and debounce key replaces the pending debounce, such that
+// the function runs once with the last event received.
+type Debounce struct {
+	// ID is the unique ID for this debounce, used to check whether the debounce
+	// has been replaced by a newer event.
+	ID ulid.ULID `json:"id"`
+	// Key is the debounce key, unique to each function and evaluated debounce
+	// key expression.
+	Key string `json:"key"`
+	// FunctionID is the ID of the function to run.
+	FunctionID string `json:"fnID"`
+	// Event is the last event received, which the function runs with.
+	Event event.Event `json:"event"`
+	// Period is the debounce period, after which the function runs.  Stores
+	// may expire the debounce some time after the period elapses.
+	Period time.Duration `json:"period"`
+}
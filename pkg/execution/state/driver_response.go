@@ -2,12 +2,14 @@ package state
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/inngest/inngest/inngest"
 	"github.com/inngest/inngest/pkg/dateutil"
 	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/event"
 	"github.com/xhit/go-str2duration/v2"
 )
 
@@ -86,6 +88,85 @@ func (w WaitForEventOpts) Expires() (time.Time, error) {
 	return time.Now().Add(dur), nil
 }
 
+// DefaultInvokeTimeout is the maximum duration that a function waits for an
+// invoked function's run to finish when no timeout is specified.
+const DefaultInvokeTimeout = 365 * 24 * time.Hour
+
+// InvokeFunctionOpts returns the options for an enums.OpcodeInvokeFunction
+// opcode, which starts a run of another function.
+func (g GeneratorOpcode) InvokeFunctionOpts() (*InvokeFunctionOpts, error) {
+	if g.Op != enums.OpcodeInvokeFunction {
+		return nil, fmt.Errorf("unable to return invoke options for opcode %s", g.Op.String())
+	}
+
+	opts := InvokeFunctionOpts{}
+	byt, err := json.Marshal(g.Opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(byt, &opts); err != nil {
+		return nil, err
+	}
+	if opts.FunctionID == "" {
+		return nil, fmt.Errorf("A function ID must be provided when invoking a function")
+	}
+	return &opts, nil
+}
+
+type InvokeFunctionOpts struct {
+	// FunctionID is the ID of the function to invoke.
+	FunctionID string `json:"function_id"`
+	// Payload is used as the data of the event which triggers the invoked
+	// function.
+	Payload map[string]any `json:"payload"`
+	// Timeout is the maximum duration to wait for the invoked function to
+	// finish, eg. "1h".  If nil, DefaultInvokeTimeout is used.
+	Timeout *string `json:"timeout"`
+}
+
+func (i InvokeFunctionOpts) Expires() (time.Time, error) {
+	if i.Timeout == nil || *i.Timeout == "" {
+		return time.Now().Add(DefaultInvokeTimeout), nil
+	}
+	dur, err := str2duration.ParseDuration(*i.Timeout)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(dur), nil
+}
+
+// SendEventOpts returns the options for an enums.OpcodeSendEvent opcode, which
+// sends events from within a function.
+func (g GeneratorOpcode) SendEventOpts() (*SendEventOpts, error) {
+	if g.Op != enums.OpcodeSendEvent {
+		return nil, fmt.Errorf("unable to return send event options for opcode %s", g.Op.String())
+	}
+
+	opts := SendEventOpts{}
+	byt, err := json.Marshal(g.Opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(byt, &opts); err != nil {
+		return nil, err
+	}
+	if len(opts.Events) == 0 {
+		return nil, fmt.Errorf("At least one event must be provided when sending events")
+	}
+	for _, evt := range opts.Events {
+		if evt.Name == "" {
+			return nil, fmt.Errorf("An event name must be provided when sending events")
+		}
+	}
+	return &opts, nil
+}
+
+type SendEventOpts struct {
+	// Events are the events to send.  Event IDs are ignored;  each event is
+	// given an ID which is deterministic for the run and step.
+	Events []event.Event `json:"events"`
+}
+
 // DriverResponse is returned after a driver executes an action.  This represents any
 // output from running the step, including the output (as a JSON map), the error, and
 // whether the driver's response is "scheduled", eg. the driver is running the job
@@ -111,7 +192,10 @@ type DriverResponse struct {
 	//    3. We store this in the state, then continue to invoke the function
 	//       with mutated state.  Each tool inside the function (step/wait)
 	//       returns a new opcode which we store in step state.
-	Generator *GeneratorOpcode `json:"generator,omitempty"`
+	//
+	// Functions may return many opcodes at once, eg. to plan several steps
+	// which run in parallel via enums.OpcodeStepPlanned.
+	Generator []*GeneratorOpcode `json:"generator,omitempty"`
 
 	// Scheduled, if set to true, represents that the action has been
 	// scheduled and will run asynchronously.  The output is not available.
@@ -221,6 +305,12 @@ func (r *DriverResponse) Final() bool {
 	return false
 }
 
+// TimedOut returns whether the step was cancelled after exceeding its max
+// duration.
+func (r DriverResponse) TimedOut() bool {
+	return errors.Is(r.Err, ErrStepTimedOut)
+}
+
 // Error allows Response to fulfil the Error interface.
 func (r DriverResponse) Error() string {
 	if r.Err == nil {
@@ -247,3 +247,64 @@ func TestGeneratorSleepDuration(t *testing.T) {
 	require.Nil(t, err)
 	require.WithinDuration(t, time.Now().Truncate(time.Second).Add(time.Minute), time.Now().Add(duration), time.Second)
 }
+
+func TestGeneratorInvokeFunctionOpts(t *testing.T) {
+	g := GeneratorOpcode{
+		Op: enums.OpcodeInvokeFunction,
+		ID: "invoke",
+		Opts: map[string]any{
+			"function_id": "child",
+			"payload":     map[string]any{"id": 1},
+			"timeout":     "1h",
+		},
+	}
+	opts, err := g.InvokeFunctionOpts()
+	require.Nil(t, err)
+	require.Equal(t, "child", opts.FunctionID)
+	require.EqualValues(t, map[string]any{"id": float64(1)}, opts.Payload)
+	expires, err := opts.Expires()
+	require.Nil(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Hour), expires, time.Second)
+
+	// Without a timeout, the default timeout is used.
+	g.Opts = map[string]any{"function_id": "child"}
+	opts, err = g.InvokeFunctionOpts()
+	require.Nil(t, err)
+	expires, err = opts.Expires()
+	require.Nil(t, err)
+	require.WithinDuration(t, time.Now().Add(DefaultInvokeTimeout), expires, time.Second)
+
+	// A function ID is required.
+	g.Opts = map[string]any{"payload": map[string]any{}}
+	_, err = g.InvokeFunctionOpts()
+	require.NotNil(t, err)
+}
+
+func TestGeneratorSendEventOpts(t *testing.T) {
+	g := GeneratorOpcode{
+		Op: enums.OpcodeSendEvent,
+		ID: "send",
+		Opts: map[string]any{
+			"events": []map[string]any{
+				{"name": "test/a", "data": map[string]any{"id": 1}},
+				{"name": "test/b"},
+			},
+		},
+	}
+	opts, err := g.SendEventOpts()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(opts.Events))
+	require.Equal(t, "test/a", opts.Events[0].Name)
+	require.EqualValues(t, map[string]any{"id": float64(1)}, opts.Events[0].Data)
+	require.Equal(t, "test/b", opts.Events[1].Name)
+
+	// Events are required.
+	g.Opts = map[string]any{"events": []map[string]any{}}
+	_, err = g.SendEventOpts()
+	require.NotNil(t, err)
+
+	// Each event must have a name.
+	g.Opts = map[string]any{"events": []map[string]any{{"data": map[string]any{}}}}
+	_, err = g.SendEventOpts()
+	require.NotNil(t, err)
+}
@@ -38,6 +38,7 @@ func EdgeExpressionData(ctx context.Context, s State, outgoingID string) map[str
 	}
 	data := map[string]interface{}{
 		"event":    s.Event(),
+		"events":   s.Events(),
 		"steps":    s.Actions(),
 		"response": response,
 	}
@@ -59,6 +60,11 @@ type EdgeEvaluator interface {
 	// children can be executed based off of the current workflow state.  Some children may not
 	// be executed due to conditional expressions or asynchronous event conditions.  This is
 	// the scheduler's job to manage.
+	//
+	// Join edges are returned as each of the join's steps finishes.  Steps which are skipped
+	// as their edge isn't traversed never finish, so join edges from skipped steps (and from
+	// their skipped children) are also returned.  The scheduler must run the join's incoming
+	// step once every join edge has been returned.
 	AvailableChildren(ctx context.Context, state State, stepID string) ([]inngest.Edge, error)
 }
 
@@ -113,6 +119,16 @@ func (i edgeEvaluator) AvailableChildren(ctx context.Context, state State, stepI
 		}
 
 		if !ok {
+			if edge.Outgoing.ID() == inngest.TriggerName || state.ActionComplete(edge.Outgoing.ID()) {
+				// The edge's expression is false, so its incoming step is
+				// skipped.  Joins which run after the skipped step must
+				// still run.
+				joins, err := i.skippedJoins(ctx, g, state, skippedEdge{stepID, edge.WorkflowEdge.Incoming}, edge.WorkflowEdge.Incoming)
+				if err != nil {
+					return nil, err
+				}
+				future = append(future, joins...)
+			}
 			continue
 		}
 
@@ -122,6 +138,9 @@ func (i edgeEvaluator) AvailableChildren(ctx context.Context, state State, stepI
 		future = append(future, edge.WorkflowEdge)
 	}
 
+	// Several skipped steps may skip the same join edge.
+	future = uniqueEdges(future)
+
 	// Sort the edges which are returned according to incoming string order.
 	sort.Slice(future, func(i, j int) bool {
 		return future[i].Incoming < future[j].Incoming
@@ -130,6 +149,95 @@ func (i edgeEvaluator) AvailableChildren(ctx context.Context, state State, stepI
 	return future, nil
 }
 
+// SkippedJoins returns the join edges which must be reported when the given
+// step is skipped as the edge from its parent wasn't traversed, eg. when an
+// async edge to the step expires without continuing.
+func SkippedJoins(ctx context.Context, s State, parentID, stepID string) ([]inngest.Edge, error) {
+	g, err := inngest.NewGraph(s.Workflow())
+	if err != nil {
+		return nil, err
+	}
+	return DefaultEdgeEvaluator.skippedJoins(ctx, g, s, skippedEdge{parentID, stepID}, stepID)
+}
+
+// skippedEdge is the edge which wasn't traversed when evaluating skipped joins.
+type skippedEdge struct {
+	outgoing string
+	incoming string
+}
+
+// skippedJoins returns the join edges from the given step if it's skipped, and
+// from any children which are skipped as a result.  Steps are skipped once the
+// edges from all of their parents aren't traversed.
+func (i edgeEvaluator) skippedJoins(ctx context.Context, g inngest.Graph, s State, skip skippedEdge, stepID string) ([]inngest.Edge, error) {
+	skipped, err := i.skipped(ctx, g, s, skip, stepID)
+	if err != nil || !skipped {
+		return nil, err
+	}
+
+	joins := []inngest.Edge{}
+	for _, edge := range g.From(stepID) {
+		if edge.WorkflowEdge.Metadata != nil && len(edge.WorkflowEdge.Metadata.Join) > 0 {
+			joins = append(joins, edge.WorkflowEdge)
+			continue
+		}
+		children, err := i.skippedJoins(ctx, g, s, skip, edge.WorkflowEdge.Incoming)
+		if err != nil {
+			return nil, err
+		}
+		joins = append(joins, children...)
+	}
+	return joins, nil
+}
+
+// skipped returns whether the given step will never run:  each of its parents
+// has finished without traversing the edge to the step, or is skipped itself.
+func (i edgeEvaluator) skipped(ctx context.Context, g inngest.Graph, s State, skip skippedEdge, stepID string) (bool, error) {
+	if stepID == inngest.TriggerName || s.ActionComplete(stepID) {
+		return false, nil
+	}
+
+	for _, edge := range g.To(stepID) {
+		parentID := edge.Outgoing.ID()
+		if parentID == skip.outgoing && stepID == skip.incoming {
+			continue
+		}
+
+		skipped, err := i.skipped(ctx, g, s, skip, parentID)
+		if err != nil {
+			return false, err
+		}
+		if skipped {
+			continue
+		}
+
+		if parentID != inngest.TriggerName && !s.ActionComplete(parentID) {
+			// The parent hasn't finished, and may still traverse the edge.
+			return false, nil
+		}
+
+		ok, err := i.canTraverseEdge(ctx, s, edge)
+		if err != nil || ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func uniqueEdges(edges []inngest.Edge) []inngest.Edge {
+	seen := map[string]bool{}
+	unique := []inngest.Edge{}
+	for _, edge := range edges {
+		key := edge.Outgoing + ":" + edge.Incoming
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, edge)
+	}
+	return unique
+}
+
 // canTraverseEdge determines whether the edge can be traversed immediately.  Edges come
 // in three flavours:  plain graph edges which link functions in a DAG;  edges with
 // expressions which are traversed conditionally based off of workflow state;  and
@@ -3,8 +3,10 @@ package state
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/inngest/inngest/inngest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,6 +20,7 @@ func TestEdgeExpressionData(t *testing.T) {
 		},
 	}
 	state.EXPECT().Event().Return(event)
+	state.EXPECT().Events().Return([]map[string]any{event})
 
 	actions := map[string]any{
 		"first": map[string]any{
@@ -34,7 +37,130 @@ func TestEdgeExpressionData(t *testing.T) {
 	result := EdgeExpressionData(context.Background(), state, "first")
 	require.EqualValues(t, map[string]any{
 		"event":    event,
+		"events":   []map[string]any{event},
 		"steps":    actions,
 		"response": first,
 	}, result)
 }
+
+func TestAvailableChildrenJoin(t *testing.T) {
+	ctx := context.Background()
+	join := &inngest.EdgeMetadata{Join: []string{"b", "c"}}
+	w := inngest.Workflow{
+		Steps: []inngest.Step{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "join"}},
+		Edges: []inngest.Edge{
+			{Outgoing: inngest.TriggerName, Incoming: "a", Metadata: &inngest.EdgeMetadata{If: "event.data.a"}},
+			{Outgoing: inngest.TriggerName, Incoming: "b"},
+			{Outgoing: "a", Incoming: "c"},
+			{Outgoing: "b", Incoming: "join", Metadata: join},
+			{Outgoing: "c", Incoming: "join", Metadata: join},
+		},
+	}
+
+	// Every expression is false, skipping step "a".
+	eval := NewEdgeEvaluator(
+		func(ctx context.Context, expression string, input map[string]interface{}) (bool, *time.Time, error) {
+			return false, nil, nil
+		},
+		func(ctx context.Context, s State, outgoingID string) map[string]interface{} {
+			return map[string]interface{}{}
+		},
+	)
+
+	ctrl := gomock.NewController(t)
+	state := NewMockState(ctrl)
+	state.EXPECT().Workflow().Return(w).AnyTimes()
+	state.EXPECT().ActionComplete("b").Return(true).AnyTimes()
+	state.EXPECT().ActionComplete(gomock.Any()).Return(false).AnyTimes()
+
+	t.Run("It returns join edges from skipped steps", func(t *testing.T) {
+		// "a" is skipped, which also skips "c" as its only parent.
+		children, err := eval.AvailableChildren(ctx, state, inngest.TriggerName)
+		require.NoError(t, err)
+		require.Equal(t, []inngest.Edge{
+			{Outgoing: inngest.TriggerName, Incoming: "b"},
+			{Outgoing: "c", Incoming: "join", Metadata: join},
+		}, children)
+	})
+
+	t.Run("It returns join edges from finished steps", func(t *testing.T) {
+		children, err := eval.AvailableChildren(ctx, state, "b")
+		require.NoError(t, err)
+		require.Equal(t, []inngest.Edge{
+			{Outgoing: "b", Incoming: "join", Metadata: join},
+		}, children)
+	})
+}
+
+func TestAvailableChildrenJoinMultipleParents(t *testing.T) {
+	ctx := context.Background()
+	join := &inngest.EdgeMetadata{Join: []string{"c", "d"}}
+	skip := &inngest.EdgeMetadata{If: "false"}
+	w := inngest.Workflow{
+		Steps: []inngest.Step{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}, {ID: "join"}},
+		Edges: []inngest.Edge{
+			{Outgoing: inngest.TriggerName, Incoming: "a"},
+			{Outgoing: inngest.TriggerName, Incoming: "b"},
+			{Outgoing: inngest.TriggerName, Incoming: "d"},
+			{Outgoing: "a", Incoming: "c", Metadata: skip},
+			{Outgoing: "b", Incoming: "c", Metadata: skip},
+			{Outgoing: "c", Incoming: "join", Metadata: join},
+			{Outgoing: "d", Incoming: "join", Metadata: join},
+		},
+	}
+
+	// Every expression is false, so neither "a" nor "b" traverses to "c".
+	eval := NewEdgeEvaluator(
+		func(ctx context.Context, expression string, input map[string]interface{}) (bool, *time.Time, error) {
+			return false, nil, nil
+		},
+		func(ctx context.Context, s State, outgoingID string) map[string]interface{} {
+			return map[string]interface{}{}
+		},
+	)
+
+	t.Run("It waits for every parent of a skipped step", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		state := NewMockState(ctrl)
+		state.EXPECT().Workflow().Return(w).AnyTimes()
+		state.EXPECT().ActionComplete("a").Return(true).AnyTimes()
+		state.EXPECT().ActionComplete(gomock.Any()).Return(false).AnyTimes()
+
+		// "b" may still traverse to "c".
+		children, err := eval.AvailableChildren(ctx, state, "a")
+		require.NoError(t, err)
+		require.Empty(t, children)
+	})
+
+	t.Run("It returns join edges once every parent skips the step", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		state := NewMockState(ctrl)
+		state.EXPECT().Workflow().Return(w).AnyTimes()
+		state.EXPECT().ActionComplete("a").Return(true).AnyTimes()
+		state.EXPECT().ActionComplete("b").Return(true).AnyTimes()
+		state.EXPECT().ActionComplete(gomock.Any()).Return(false).AnyTimes()
+
+		for _, parent := range []string{"a", "b"} {
+			children, err := eval.AvailableChildren(ctx, state, parent)
+			require.NoError(t, err)
+			require.Equal(t, []inngest.Edge{
+				{Outgoing: "c", Incoming: "join", Metadata: join},
+			}, children)
+		}
+	})
+
+	t.Run("It waits for parents which aren't skipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		state := NewMockState(ctrl)
+		state.EXPECT().Workflow().Return(w).AnyTimes()
+		state.EXPECT().ActionComplete(gomock.Any()).Return(false).AnyTimes()
+		state.EXPECT().Event().Return(map[string]any{}).AnyTimes()
+		state.EXPECT().Events().Return([]map[string]any{}).AnyTimes()
+		state.EXPECT().Actions().Return(map[string]any{}).AnyTimes()
+
+		// The trigger's edge to "b" is traversed, so "c" may still run.
+		joins, err := SkippedJoins(ctx, state, inngest.TriggerName, "a")
+		require.NoError(t, err)
+		require.Empty(t, joins)
+	})
+}
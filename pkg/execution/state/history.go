@@ -40,7 +40,8 @@ func (h *History) UnmarshalJSON(data []byte) error {
 		enums.HistoryTypeStepStarted,
 		enums.HistoryTypeStepCompleted,
 		enums.HistoryTypeStepErrored,
-		enums.HistoryTypeStepFailed:
+		enums.HistoryTypeStepFailed,
+		enums.HistoryTypeStepTimedOut:
 		v := struct {
 			Data HistoryStep `json:"data"`
 		}{}
@@ -48,6 +49,22 @@ func (h *History) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		h.Data = v.Data
+	case enums.HistoryTypeFunctionCancelled:
+		v := struct {
+			Data HistoryFunctionCancelled `json:"data"`
+		}{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		h.Data = v.Data
+	case enums.HistoryTypeFunctionSkipped:
+		v := struct {
+			Data HistoryFunctionSkipped `json:"data"`
+		}{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		h.Data = v.Data
 	}
 
 	return nil
@@ -92,9 +109,21 @@ func (h *History) UnmarshalBinary(data []byte) error {
 	return fmt.Errorf("history had no recognised prefix")
 }
 
+// HistoryFunctionCancelled is stored when a function run is cancelled.
 type HistoryFunctionCancelled struct {
+	// Type records how the run was cancelled, eg. by an event or manually.
 	Type enums.CancellationType `json:"type"`
-	Data any                    `json:"data"`
+	// Data stores the cancellation's data, eg. the cancelling event.
+	Data any `json:"data"`
+}
+
+// HistoryFunctionSkipped is stored when a function run is skipped, eg. as a
+// run with the same idempotency key already exists.
+type HistoryFunctionSkipped struct {
+	// Key is the idempotency key of the skipped run.
+	Key string `json:"key"`
+	// Event is the event data which triggered the skipped run.
+	Event map[string]any `json:"event"`
 }
 
 // TODO Add tracking of the parent steps so that we can create a visual DAG
@@ -47,30 +47,39 @@ func (c *Config) Manager(ctx context.Context) (state.Manager, error) {
 // functions in-memory, for development and testing only.
 func NewStateManager() state.Manager {
 	return &mem{
-		idempotency: map[string]struct{}{},
+		idempotency: map[string]time.Time{},
 		state:       map[ulid.ULID]state.State{},
 		pauses:      map[uuid.UUID]state.Pause{},
 		leases:      map[uuid.UUID]time.Time{},
 		history:     map[string][]state.History{},
+		debounces:   map[string]state.Debounce{},
+		reported:    map[string][]string{},
 		lock:        &sync.RWMutex{},
+		callbacks:   &state.FunctionCallbacks{},
 	}
 }
 
 type mem struct {
-	idempotency map[string]struct{}
+	// idempotency stores idempotency keys and the time at which each key
+	// expires.  Keys with a zero time never expire.
+	idempotency map[string]time.Time
 	state       map[ulid.ULID]state.State
 	pauses      map[uuid.UUID]state.Pause
 	leases      map[uuid.UUID]time.Time
 	history     map[string][]state.History
-	lock        *sync.RWMutex
+	debounces   map[string]state.Debounce
+	// reported stores the IDs of reported generator steps, keyed by run
+	// ID and group ID.
+	reported map[string][]string
+	lock     *sync.RWMutex
 
-	callbacks []state.FunctionCallback
+	callbacks *state.FunctionCallbacks
 }
 
 // OnFunctionStatus adds a callback to be called whenever functions
 // transition status.
 func (m *mem) OnFunctionStatus(f state.FunctionCallback) {
-	m.callbacks = append(m.callbacks, f)
+	m.callbacks.Add(f)
 }
 
 func (m *mem) IsComplete(ctx context.Context, runID ulid.ULID) (bool, error) {
@@ -97,22 +106,46 @@ func (m *mem) New(ctx context.Context, input state.Input) (state.State, error) {
 			OriginalRunID: input.OriginalRunID,
 			Context:       input.Context,
 			Identifier:    input.Identifier,
+			EventIDs:      input.EventIDs,
 		},
 		workflow:   input.Workflow,
 		identifier: input.Identifier,
 		event:      input.EventData,
+		events:     input.Events,
 		actions:    input.Steps,
 		errors:     map[string]error{},
 	}
 
-	if _, ok := m.idempotency[input.Identifier.IdempotencyKey()]; ok {
+	if _, ok := m.state[input.Identifier.RunID]; ok {
 		return nil, state.ErrIdentifierExists
 	}
-	if _, ok := m.state[input.Identifier.RunID]; ok {
+
+	if input.Skipped {
+		s.metadata.Status = enums.RunStatusSkipped
+		s.metadata.Pending = 0
+		m.state[input.Identifier.RunID] = s
+		m.setHistory(ctx, input.Identifier, state.History{
+			Type:       enums.HistoryTypeFunctionSkipped,
+			Identifier: input.Identifier,
+			CreatedAt:  time.UnixMilli(int64(input.Identifier.RunID.Time())),
+			Data: state.HistoryFunctionSkipped{
+				Key:   input.Identifier.Key,
+				Event: input.EventData,
+			},
+		})
+		return s, nil
+	}
+
+	key := input.Identifier.IdempotencyKey()
+	if expires, ok := m.idempotency[key]; ok && (expires.IsZero() || expires.After(time.Now())) {
 		return nil, state.ErrIdentifierExists
 	}
 
-	m.idempotency[input.Identifier.IdempotencyKey()] = struct{}{}
+	var expires time.Time
+	if input.IdempotencyTTL > 0 {
+		expires = time.Now().Add(input.IdempotencyTTL)
+	}
+	m.idempotency[key] = expires
 	m.state[input.Identifier.RunID] = s
 
 	m.setHistory(ctx, input.Identifier, state.History{
@@ -122,7 +155,7 @@ func (m *mem) New(ctx context.Context, input state.Input) (state.State, error) {
 		Data:       input.EventData,
 	})
 
-	go m.runCallbacks(ctx, input.Identifier, enums.RunStatusRunning)
+	m.callbacks.Run(input.Identifier, enums.RunStatusRunning)
 
 	return s, nil
 
@@ -200,9 +233,14 @@ func (m *mem) Finalized(ctx context.Context, i state.Identifier, stepID string,
 
 	instance := s.(memstate)
 	instance.metadata.Pending--
+	if instance.metadata.Pending == 0 {
+		// The run has no outstanding steps, so remove all pauses such as
+		// cancellation pauses.
+		m.deleteRunPauses(i.RunID)
+	}
 	if instance.metadata.Pending == 0 && instance.metadata.Status == enums.RunStatusRunning {
 		instance.metadata.Status = finalStatus
-		go m.runCallbacks(ctx, i, enums.RunStatusCompleted)
+		m.callbacks.Run(i, enums.RunStatusCompleted)
 
 		m.setHistory(ctx, i, state.History{
 			Type:       enums.HistoryTypeFunctionCompleted,
@@ -216,7 +254,47 @@ func (m *mem) Finalized(ctx context.Context, i state.Identifier, stepID string,
 	return nil
 }
 
-func (m *mem) Cancel(ctx context.Context, i state.Identifier) error {
+func (m *mem) Reported(ctx context.Context, i state.Identifier, groupID string, stepID string, size int) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.state[i.RunID]; !ok {
+		return false, fmt.Errorf("identifier not found")
+	}
+
+	key := fmt.Sprintf("%s:%s", i.RunID, groupID)
+	for _, id := range m.reported[key] {
+		if id == stepID {
+			// This step has already reported.
+			return len(m.reported[key]) == size, nil
+		}
+	}
+	m.reported[key] = append(m.reported[key], stepID)
+	return len(m.reported[key]) == size, nil
+}
+
+// RecordEvent records the event alongside idempotency keys, using a prefix which
+// can't collide with run idempotency keys.
+func (m *mem) RecordEvent(ctx context.Context, eventID string, ttl time.Duration) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	key := "event:" + eventID
+	if expires, ok := m.idempotency[key]; ok && expires.After(time.Now()) {
+		return false, nil
+	}
+	m.idempotency[key] = time.Now().Add(ttl)
+	return true, nil
+}
+
+func (m *mem) ForgetEvent(ctx context.Context, eventID string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.idempotency, "event:"+eventID)
+	return nil
+}
+
+func (m *mem) Cancel(ctx context.Context, i state.Identifier, c state.HistoryFunctionCancelled) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -238,17 +316,32 @@ func (m *mem) Cancel(ctx context.Context, i state.Identifier) error {
 	instance.metadata.Status = enums.RunStatusCancelled
 	m.state[i.RunID] = instance
 
-	go m.runCallbacks(ctx, i, enums.RunStatusCancelled)
+	// Cancelled runs can no longer be resumed, so remove all outstanding pauses.
+	m.deleteRunPauses(i.RunID)
+
+	m.callbacks.Run(i, enums.RunStatusCancelled)
 
 	m.setHistory(ctx, i, state.History{
 		Type:       enums.HistoryTypeFunctionCancelled,
 		Identifier: i,
 		CreatedAt:  time.UnixMilli(time.Now().UnixMilli()),
+		Data:       c,
 	})
 
 	return nil
 }
 
+// deleteRunPauses deletes all pauses for the given run.  This must be called
+// with the lock held.
+func (m *mem) deleteRunPauses(runID ulid.ULID) {
+	for id, p := range m.pauses {
+		if p.Identifier.RunID == runID {
+			delete(m.pauses, id)
+			delete(m.leases, id)
+		}
+	}
+}
+
 func (m *mem) SaveResponse(ctx context.Context, i state.Identifier, r state.DriverResponse, attempt int) (state.State, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -284,6 +377,9 @@ func (m *mem) SaveResponse(ctx context.Context, i state.Identifier, r state.Driv
 		instance.errors[r.Step.ID] = r.Err
 
 		typ := enums.HistoryTypeStepErrored
+		if r.TimedOut() {
+			typ = enums.HistoryTypeStepTimedOut
+		}
 		if r.Final() {
 			typ = enums.HistoryTypeStepFailed
 		}
@@ -304,7 +400,7 @@ func (m *mem) SaveResponse(ctx context.Context, i state.Identifier, r state.Driv
 	if r.Final() {
 		instance.metadata.Pending--
 		instance.metadata.Status = enums.RunStatusFailed
-		go m.runCallbacks(ctx, i, enums.RunStatusFailed)
+		m.callbacks.Run(i, enums.RunStatusFailed)
 		m.setHistory(ctx, i, state.History{
 			Type:       enums.HistoryTypeFunctionFailed,
 			Identifier: i,
@@ -375,6 +471,32 @@ func (m *mem) PausesByEvent(ctx context.Context, workspaceID uuid.UUID, eventNam
 	return i, nil
 }
 
+func (m *mem) PausesByEventMatch(ctx context.Context, workspaceID uuid.UUID, eventName string, keys []string) (state.PauseIterator, error) {
+	match := map[string]struct{}{}
+	for _, k := range keys {
+		match[k] = struct{}{}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	subset := []*state.Pause{}
+	for _, p := range m.pauses {
+		copied := p
+		if p.Event == nil || *p.Event != eventName || p.WorkspaceID != workspaceID {
+			continue
+		}
+		if p.MatchKey != nil {
+			if _, ok := match[*p.MatchKey]; !ok {
+				continue
+			}
+		}
+		subset = append(subset, &copied)
+	}
+
+	i := &pauseIterator{pauses: subset}
+	return i, nil
+}
+
 func (m *mem) PauseByStep(ctx context.Context, i state.Identifier, actionID string) (*state.Pause, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
@@ -425,6 +547,34 @@ func (m *mem) ConsumePause(ctx context.Context, id uuid.UUID, data any) error {
 	return nil
 }
 
+func (m *mem) SaveDebounce(ctx context.Context, d state.Debounce) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.debounces[d.Key] = d
+	return nil
+}
+
+func (m *mem) RestoreDebounce(ctx context.Context, d state.Debounce) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.debounces[d.Key]; !ok {
+		m.debounces[d.Key] = d
+	}
+	return nil
+}
+
+func (m *mem) ConsumeDebounce(ctx context.Context, key string, id ulid.ULID) (*state.Debounce, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	d, ok := m.debounces[key]
+	if !ok || d.ID != id {
+		return nil, state.ErrDebounceNotFound
+	}
+	delete(m.debounces, key)
+	return &d, nil
+}
+
 func (m *mem) History(ctx context.Context, runID ulid.ULID) ([]state.History, error) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -446,21 +596,18 @@ func (m *mem) Runs(ctx context.Context, eventId string) ([]state.Metadata, error
 	defer m.lock.RUnlock()
 
 	for _, s := range m.state {
-		if eventId != "" {
-			evt := s.Event()
-			if evt == nil || evt["id"] != eventId {
-				continue
-			}
+		if eventId != "" && !triggeredBy(s, eventId) {
+			continue
 		}
 
 		met := s.Metadata()
-		id := s.RunID()
 
 		metadata = append(metadata, state.Metadata{
+			Identifier:    s.Identifier(),
 			Status:        met.Status,
 			Debugger:      met.Debugger,
 			RunType:       met.RunType,
-			OriginalRunID: &id,
+			OriginalRunID: met.OriginalRunID,
 			Pending:       met.Pending,
 			Name:          s.Workflow().Name,
 		})
@@ -469,6 +616,39 @@ func (m *mem) Runs(ctx context.Context, eventId string) ([]state.Metadata, error
 	return metadata, nil
 }
 
+// RunningRuns returns the identifiers of a function's running runs which started
+// within the given time range.
+func (m *mem) RunningRuns(ctx context.Context, workflowID uuid.UUID, from, to time.Time) ([]state.Identifier, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	ids := []state.Identifier{}
+	for _, s := range m.state {
+		id := s.Identifier()
+		if id.WorkflowID != workflowID || s.Metadata().Status != enums.RunStatusRunning {
+			continue
+		}
+		started := time.UnixMilli(int64(id.RunID.Time()))
+		if started.Before(from) || !started.Before(to) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// triggeredBy returns whether the given event ID triggered the run, either as
+// the root event or as part of a batch.
+func triggeredBy(s state.State, eventId string) bool {
+	for _, id := range s.Metadata().EventIDs {
+		if id == eventId {
+			return true
+		}
+	}
+	evt := s.Event()
+	return evt != nil && evt["id"] == eventId
+}
+
 func (m *mem) setHistory(ctx context.Context, i state.Identifier, entry state.History) {
 	_, ok := m.history[i.RunID.String()]
 	if !ok {
@@ -477,14 +657,6 @@ func (m *mem) setHistory(ctx context.Context, i state.Identifier, entry state.Hi
 	m.history[i.RunID.String()] = append(m.history[i.RunID.String()], entry)
 }
 
-func (m mem) runCallbacks(ctx context.Context, id state.Identifier, status enums.RunStatus) {
-	for _, f := range m.callbacks {
-		go func(fn state.FunctionCallback) {
-			fn(ctx, id, status)
-		}(f)
-	}
-}
-
 func copyMap[K comparable, V any](m map[K]V) map[K]V {
 	copied := map[K]V{}
 	for k, v := range m {
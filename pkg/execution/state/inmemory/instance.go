@@ -22,6 +22,7 @@ func NewStateInstance(
 	id state.Identifier,
 	metadata state.Metadata,
 	event map[string]any,
+	events []map[string]any,
 	actions map[string]any,
 	errors map[string]error,
 ) state.State {
@@ -30,6 +31,7 @@ func NewStateInstance(
 		identifier: id,
 		metadata:   metadata,
 		event:      event,
+		events:     events,
 		actions:    actions,
 		errors:     errors,
 	}
@@ -46,6 +48,10 @@ type memstate struct {
 	// an Inngest event.
 	event map[string]interface{}
 
+	// events stores the batch of events which triggered the workflow, if the
+	// run was started by a batch trigger.
+	events []map[string]any
+
 	// Actions stores a map of all output from each individual action
 	actions map[string]any
 
@@ -77,6 +83,13 @@ func (s memstate) Event() map[string]interface{} {
 	return s.event
 }
 
+func (s memstate) Events() []map[string]interface{} {
+	if len(s.events) == 0 && s.event != nil {
+		return []map[string]any{s.event}
+	}
+	return s.events
+}
+
 func (s memstate) Actions() map[string]any {
 	return s.actions
 }
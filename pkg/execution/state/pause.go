@@ -2,9 +2,26 @@ package state
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/expressions"
+)
+
+const (
+	// pauseMatchRoot is the expression variable which holds the incoming event
+	// when evaluating pause expressions.
+	pauseMatchRoot = "async"
+
+	// maxMatchKeyValueLen is the longest JSON-encoded value stored within a
+	// match key.  Longer values are hashed, keeping keys small enough to index.
+	maxMatchKeyValueLen = 128
 )
 
 // PauseMutater manages creating, leasing, and consuming pauses from a backend implementation.
@@ -41,6 +58,13 @@ type PauseGetter interface {
 	// PausesByEvent returns all pauses for a given event, in a given workspace.
 	PausesByEvent(ctx context.Context, workspaceID uuid.UUID, eventName string) (PauseIterator, error)
 
+	// PausesByEventMatch returns the pauses for a given event, in a given workspace,
+	// which may match an event with the given match keys:  all pauses without a
+	// MatchKey, plus pauses whose MatchKey is one of the given keys.
+	//
+	// The keys for an incoming event are created using PauseMatchKeys.
+	PausesByEventMatch(ctx context.Context, workspaceID uuid.UUID, eventName string, keys []string) (PauseIterator, error)
+
 	// PauseByStep returns a specific pause for a given workflow run, from a given step.
 	//
 	// This is required when continuing a step function from an async step, ie. one that
@@ -138,6 +162,140 @@ type Pause struct {
 	// via an async driver.  This lets the executor resume as-is with the current
 	// context, ensuring that we retry correctly.
 	Attempt int `json:"att,omitempty"`
+	// MatchKey optionally indexes the pause by the data of events which can
+	// match the pause's expression.  If set, the expression can only match
+	// events whose match keys, as returned by PauseMatchKeys, include this key.
+	//
+	// This is set by PrepareExpression.
+	MatchKey *string `json:"mk,omitempty"`
+}
+
+// PrepareExpression analyses the pause's expression before the pause is saved,
+// given the data used to evaluate the expression excluding the incoming event,
+// eg. from EdgeExpressionData.
+//
+// Data referenced by the expression is stored within ExpressionData, so that the
+// run's state needn't be loaded when evaluating the pause.  If the expression
+// requires an attribute of the incoming event to equal a value, eg.
+// `async.data.id == event.data.id`, the pause is indexed by that attribute and
+// value within MatchKey.
+func (p *Pause) PrepareExpression(ctx context.Context, data map[string]any) error {
+	if p.Expression == nil {
+		return nil
+	}
+
+	eval, err := expressions.NewExpressionEvaluator(ctx, *p.Expression)
+	if err != nil {
+		return err
+	}
+	p.ExpressionData = eval.FilteredAttributes(ctx, expressions.NewData(data)).Map()
+
+	eqs, err := expressions.Equalities(ctx, *p.Expression, pauseMatchRoot)
+	if err != nil {
+		return err
+	}
+	for _, eq := range eqs {
+		val, _, err := expressions.Evaluate(ctx, eq.Expression, p.ExpressionData)
+		if err != nil {
+			continue
+		}
+		key, ok := pauseMatchKey(eq.Path, val)
+		if !ok {
+			continue
+		}
+		// Expressions referencing missing attributes evaluate to false.  Ensure
+		// that the attribute really must equal the value before indexing.
+		if !pauseMatchHolds(ctx, eq, val, p.ExpressionData) {
+			continue
+		}
+		p.MatchKey = &key
+		return nil
+	}
+	return nil
+}
+
+// pauseMatchHolds returns whether the given equality is true when the
+// attribute at the equality's path is set to val.
+func pauseMatchHolds(ctx context.Context, eq expressions.Equality, val any, data map[string]any) bool {
+	attr := val
+	for i := len(eq.Path) - 1; i >= 0; i-- {
+		attr = map[string]any{eq.Path[i]: attr}
+	}
+	input := make(map[string]any, len(data)+1)
+	for k, v := range data {
+		input[k] = v
+	}
+	input[pauseMatchRoot] = attr
+
+	expr := fmt.Sprintf("%s.%s == (%s)", pauseMatchRoot, strings.Join(eq.Path, "."), eq.Expression)
+	ok, _, err := expressions.EvaluateBoolean(ctx, expr, input)
+	return err == nil && ok
+}
+
+// PauseMatchKeys returns the match keys for the given event, as returned from
+// event.Event's Map method.  Pauses with a MatchKey can only match events whose
+// keys include the pause's MatchKey.
+func PauseMatchKeys(evt map[string]any) []string {
+	keys := []string{}
+
+	var walk func(path []string, val any)
+	walk = func(path []string, val any) {
+		if m, ok := val.(map[string]any); ok {
+			for field, v := range m {
+				walk(append(path[:len(path):len(path)], field), v)
+			}
+			return
+		}
+		if key, ok := pauseMatchKey(path, val); ok {
+			keys = append(keys, key)
+		}
+	}
+	walk(nil, evt)
+
+	sort.Strings(keys)
+	return keys
+}
+
+// pauseMatchKey returns the match key for an attribute of the incoming event
+// and the value that it equals.  Only strings, numbers and booleans are used
+// within match keys.
+func pauseMatchKey(path []string, val any) (string, bool) {
+	if len(path) == 0 {
+		return "", false
+	}
+
+	// Expressions compare numbers of any type, so each is keyed as a float.
+	switch v := val.(type) {
+	case string, bool, float64:
+	case float32:
+		val = float64(v)
+	case int:
+		val = float64(v)
+	case int32:
+		val = float64(v)
+	case int64:
+		val = float64(v)
+	case uint:
+		val = float64(v)
+	case uint32:
+		val = float64(v)
+	case uint64:
+		val = float64(v)
+	default:
+		return "", false
+	}
+
+	byt, err := json.Marshal(val)
+	if err != nil {
+		// NaN and infinite values can't be encoded.
+		return "", false
+	}
+	encoded := string(byt)
+	if len(encoded) > maxMatchKeyValueLen {
+		sum := sha256.Sum256(byt)
+		encoded = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	return fmt.Sprintf("%s=%s", strings.Join(path, "."), encoded), true
 }
 
 func (p Pause) Edge() inngest.Edge {
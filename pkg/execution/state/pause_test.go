@@ -0,0 +1,126 @@
+package state
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPausePrepareExpression(t *testing.T) {
+	ctx := context.Background()
+	data := map[string]any{
+		"event": map[string]any{
+			"data": map[string]any{
+				"id":    "abc",
+				"count": 2,
+				"flag":  false,
+				"large": strings.Repeat("a", 1024),
+			},
+		},
+	}
+
+	tests := []struct {
+		expr     string
+		matchKey *string
+		data     map[string]any
+	}{
+		{
+			expr:     `async.data.id == event.data.id`,
+			matchKey: strptr(`data.id="abc"`),
+			data:     map[string]any{"event": map[string]any{"data": map[string]any{"id": "abc"}}},
+		},
+		{
+			// Numbers are keyed as floats, as expressions compare ints and
+			// doubles as equal.
+			expr:     `async.data.count == event.data.count && async.data.ok`,
+			matchKey: strptr(`data.count=2`),
+			data:     map[string]any{"event": map[string]any{"data": map[string]any{"count": 2}}},
+		},
+		{
+			expr:     `async.data.id == "abc" || async.data.id == "def"`,
+			matchKey: nil,
+			data:     map[string]any{},
+		},
+		{
+			expr:     `async.data.flag == event.data.flag`,
+			matchKey: strptr(`data.flag=false`),
+			data:     map[string]any{"event": map[string]any{"data": map[string]any{"flag": false}}},
+		},
+		{
+			// Missing attributes are never indexed.
+			expr:     `async.data.id == event.data.missing`,
+			matchKey: nil,
+			data:     map[string]any{},
+		},
+	}
+
+	for _, test := range tests {
+		expr := test.expr
+		p := Pause{Expression: &expr}
+		err := p.PrepareExpression(ctx, data)
+		require.NoError(t, err, test.expr)
+		require.Equal(t, test.matchKey, p.MatchKey, test.expr)
+		require.EqualValues(t, test.data, p.ExpressionData, test.expr)
+	}
+
+	// Large values are hashed.
+	expr := `event.data.large == async.data.large`
+	p := Pause{Expression: &expr}
+	err := p.PrepareExpression(ctx, data)
+	require.NoError(t, err)
+	require.NotNil(t, p.MatchKey)
+	require.True(t, strings.HasPrefix(*p.MatchKey, "data.large=sha256:"))
+
+	// Pauses without expressions are left untouched.
+	p = Pause{}
+	err = p.PrepareExpression(ctx, data)
+	require.NoError(t, err)
+	require.Nil(t, p.MatchKey)
+	require.Nil(t, p.ExpressionData)
+}
+
+func TestPauseMatchKeys(t *testing.T) {
+	evt := map[string]any{
+		"name": "test/event",
+		"data": map[string]any{
+			"id":    "abc",
+			"count": float64(2),
+			"ok":    true,
+			"large": strings.Repeat("a", 1024),
+			"list":  []any{"a", "b"},
+			"nested": map[string]any{
+				"id": "def",
+			},
+			"null": nil,
+		},
+	}
+
+	keys := PauseMatchKeys(evt)
+	require.Len(t, keys, 6)
+	require.Contains(t, keys, `name="test/event"`)
+	require.Contains(t, keys, `data.id="abc"`)
+	require.Contains(t, keys, `data.count=2`)
+	require.Contains(t, keys, `data.ok=true`)
+	require.Contains(t, keys, `data.nested.id="def"`)
+
+	// Each key must match the key of a pause prepared with the same value.
+	ctx := context.Background()
+	for _, expr := range []string{
+		`async.data.large == event.data.large`,
+		`async.data.nested.id == event.data.nested.id`,
+		`async.data.count == event.data.count`,
+	} {
+		expr := expr
+		p := Pause{Expression: &expr}
+		err := p.PrepareExpression(ctx, map[string]any{"event": evt})
+		require.NoError(t, err)
+		require.NotNil(t, p.MatchKey, expr)
+		require.Contains(t, keys, *p.MatchKey, expr)
+	}
+}
+
+func strptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,103 @@
+-- +goose Up
+
+-- state_runs stores the metadata for each function run, including the pending
+-- step count used to determine when a run completes.
+CREATE TABLE public.state_runs (
+  run_id character(26) NOT NULL,
+  workflow_id uuid NOT NULL,
+  workflow_version integer NOT NULL,
+  -- the JSON-encoded state.Identifier for the run
+  identifier text NOT NULL,
+  status integer NOT NULL DEFAULT 0,
+  pending integer NOT NULL DEFAULT 0,
+  debugger boolean NOT NULL DEFAULT false,
+  run_type character varying(255),
+  original_run_id character(26),
+  -- JSON-encoded metadata context
+  context text,
+  -- JSON-encoded array of triggering event IDs
+  event_ids text,
+  -- the JSON-encoded triggering event
+  event text NOT NULL,
+  -- the JSON-encoded batch of triggering events, for batched runs only
+  batch text,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  PRIMARY KEY (run_id)
+);
+
+CREATE INDEX state_runs_workflow ON public.state_runs USING btree (workflow_id, workflow_version);
+
+-- state_idempotency_keys ensures that each idempotency key starts a single run.
+CREATE TABLE public.state_idempotency_keys (
+  key text NOT NULL,
+  -- keys with a null expiry are held forever
+  expires_at timestamp with time zone,
+  PRIMARY KEY (key)
+);
+
+-- state_workflows stores the configuration for each workflow version that has
+-- been run.
+CREATE TABLE public.state_workflows (
+  workflow_id uuid NOT NULL,
+  workflow_version integer NOT NULL,
+  workflow text NOT NULL,
+  PRIMARY KEY (workflow_id, workflow_version)
+);
+
+-- state_steps stores the output and latest error for each step of a run.
+CREATE TABLE public.state_steps (
+  run_id character(26) NOT NULL,
+  step_id character varying(255) NOT NULL,
+  -- JSON-encoded step output, null if the step has no output
+  output text,
+  -- the step's latest error, null if the step has not errored
+  error text,
+  PRIMARY KEY (run_id, step_id)
+);
+
+-- state_history stores the JSON-encoded history log for each run.
+CREATE TABLE public.state_history (
+  id bigserial NOT NULL,
+  run_id character(26) NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  data text NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX state_history_run ON public.state_history USING btree (run_id, created_at, id);
+
+-- state_pauses stores unconsumed pauses.
+CREATE TABLE public.state_pauses (
+  id uuid NOT NULL,
+  workspace_id uuid NOT NULL,
+  run_id character(26) NOT NULL,
+  incoming character varying(255) NOT NULL,
+  event character varying(255),
+  -- the JSON-encoded state.Pause
+  data text NOT NULL,
+  expires_at timestamp with time zone NOT NULL,
+  leased_until timestamp with time zone,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX state_pauses_event ON public.state_pauses USING btree (workspace_id, event, id) WHERE event IS NOT NULL;
+CREATE INDEX state_pauses_step ON public.state_pauses USING btree (run_id, incoming);
+
+-- state_debounces stores the pending run for each debounce key.
+CREATE TABLE public.state_debounces (
+  key text NOT NULL,
+  id character(26) NOT NULL,
+  -- the JSON-encoded state.Debounce
+  data text NOT NULL,
+  PRIMARY KEY (key)
+);
+
+-- +goose Down
+DROP TABLE public.state_debounces;
+DROP TABLE public.state_pauses;
+DROP TABLE public.state_history;
+DROP TABLE public.state_steps;
+DROP TABLE public.state_workflows;
+DROP TABLE public.state_idempotency_keys;
+DROP TABLE public.state_runs;
@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- match_key stores the attribute value that an event must contain for the
+-- pause's expression to match, allowing events to load only candidate pauses.
+ALTER TABLE public.state_pauses ADD COLUMN match_key text;
+
+CREATE INDEX state_pauses_event_match ON public.state_pauses USING btree (workspace_id, event, match_key, id) WHERE event IS NOT NULL;
+
+-- +goose Down
+DROP INDEX public.state_pauses_event_match;
+ALTER TABLE public.state_pauses DROP COLUMN match_key;
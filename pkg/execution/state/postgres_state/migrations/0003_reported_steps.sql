@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- state_reported_steps stores the generator steps that have reported within
+-- each group of steps planned in parallel.
+CREATE TABLE public.state_reported_steps (
+  run_id character(26) NOT NULL,
+  group_id character varying(255) NOT NULL,
+  step_id character varying(255) NOT NULL,
+  PRIMARY KEY (run_id, group_id, step_id)
+);
+
+-- +goose Down
+DROP TABLE public.state_reported_steps;
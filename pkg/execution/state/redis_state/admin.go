@@ -0,0 +1,252 @@
+package redis_state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	json "github.com/goccy/go-json"
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/oklog/ulid/v2"
+)
+
+// Partitions returns up to limit partitions, ordered by the time of the partition's
+// next available item.  This only reads from the queue, and never leases partitions.
+func (q *queue) Partitions(ctx context.Context, limit int64) ([]osqueue.Partition, error) {
+	if limit <= 0 {
+		limit = PartitionPeekMax
+	}
+
+	ids, err := q.r.ZRange(ctx, q.kg.PartitionIndex(), 0, limit-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error reading partition index: %w", err)
+	}
+	if len(ids) == 0 {
+		return []osqueue.Partition{}, nil
+	}
+
+	encoded, err := q.r.HMGet(ctx, q.kg.PartitionItem(), ids...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error reading partitions: %w", err)
+	}
+
+	pipe := q.r.Pipeline()
+	lens := make([]*redis.IntCmd, len(ids))
+	for n, id := range ids {
+		lens[n] = pipe.ZCard(ctx, q.kg.QueueIndex(id))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("error reading partition lengths: %w", err)
+	}
+
+	result := []osqueue.Partition{}
+	for n, val := range encoded {
+		str, ok := val.(string)
+		if !ok {
+			// The partition was removed after reading the index.
+			continue
+		}
+		qp := QueuePartition{}
+		if err := json.Unmarshal([]byte(str), &qp); err != nil {
+			return nil, fmt.Errorf("error reading partition: %w", err)
+		}
+		p := osqueue.Partition{
+			WorkflowID: qp.WorkflowID,
+			Priority:   qp.Priority,
+			At:         time.Unix(qp.AtS, 0),
+			Len:        lens[n].Val(),
+		}
+		if qp.LeaseID != nil && time.Now().Before(ulid.Time(qp.LeaseID.Time())) {
+			p.LeaseID = qp.LeaseID
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// PartitionItems returns up to limit unleased items for the given function, ordered
+// by the time each item runs.  This only reads from the queue.
+func (q *queue) PartitionItems(ctx context.Context, workflowID uuid.UUID, limit int64) ([]osqueue.QueuedItem, error) {
+	if limit <= 0 || limit > QueuePeekMax {
+		limit = QueuePeekMax
+	}
+
+	// Read all items, no matter how far in the future they're scheduled.
+	ids, err := q.r.ZRange(ctx, q.kg.QueueIndex(workflowID.String()), 0, limit-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error reading queue index: %w", err)
+	}
+	items, err := q.items(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := []osqueue.QueuedItem{}
+	for _, qi := range items {
+		if qi.LeaseID != nil && now.Before(ulid.Time(qi.LeaseID.Time())) {
+			continue
+		}
+		result = append(result, queuedItem(qi))
+	}
+	return result, nil
+}
+
+// Leases returns all active leases held by workers, including the sequential
+// scanner's lease and leases for up to limit partitions.
+func (q *queue) Leases(ctx context.Context, limit int64) ([]osqueue.Lease, error) {
+	now := time.Now()
+	result := []osqueue.Lease{}
+
+	seq, err := q.r.Get(ctx, q.kg.Sequential()).Result()
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("error reading sequential lease: %w", err)
+	}
+	if leaseID, err := ulid.Parse(seq); err == nil && now.Before(ulid.Time(leaseID.Time())) {
+		result = append(result, lease(osqueue.LeaseKindSequential, "", leaseID))
+	}
+
+	partitions, err := q.Partitions(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Leased items are scored by their lease expiry in milliseconds, so only
+	// items scored in the future can be leased.
+	pipe := q.r.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, len(partitions))
+	for n, p := range partitions {
+		if p.LeaseID != nil {
+			result = append(result, lease(osqueue.LeaseKindPartition, p.WorkflowID.String(), *p.LeaseID))
+		}
+		cmds[n] = pipe.ZRangeByScore(ctx, q.kg.QueueIndex(p.WorkflowID.String()), &redis.ZRangeBy{
+			Min:   fmt.Sprintf("%d", now.UnixMilli()),
+			Max:   "+inf",
+			Count: QueuePeekMax,
+		})
+	}
+	if len(cmds) > 0 {
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			return nil, fmt.Errorf("error reading queue indexes: %w", err)
+		}
+	}
+
+	ids := []string{}
+	for _, cmd := range cmds {
+		ids = append(ids, cmd.Val()...)
+	}
+	items, err := q.items(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, qi := range items {
+		if qi.LeaseID != nil && now.Before(ulid.Time(qi.LeaseID.Time())) {
+			result = append(result, lease(osqueue.LeaseKindItem, qi.ID, *qi.LeaseID))
+		}
+	}
+
+	return result, nil
+}
+
+// ReprioritizePartition updates the priority of the given function's partition.
+func (q *queue) ReprioritizePartition(ctx context.Context, workflowID uuid.UUID, priority uint) error {
+	err := q.PartitionReprioritize(ctx, workflowID, priority)
+	if err == ErrPartitionNotFound {
+		return osqueue.ErrAdminPartitionNotFound
+	}
+	return err
+}
+
+// DeleteItem removes the given item from the queue, as long as the item isn't being
+// processed by a worker.
+func (q *queue) DeleteItem(ctx context.Context, id string) error {
+	qi, err := q.item(ctx, id)
+	if err != nil {
+		return err
+	}
+	return adminErr(q.dequeue(ctx, *qi, time.Now()))
+}
+
+// RequeueItem reschedules the given item to run at the given time, as long as the
+// item isn't being processed by a worker.
+func (q *queue) RequeueItem(ctx context.Context, id string, at time.Time) error {
+	qi, err := q.item(ctx, id)
+	if err != nil {
+		return err
+	}
+	return adminErr(q.requeue(ctx, *qi, at, time.Now()))
+}
+
+// item loads a single queue item by its ID.
+func (q *queue) item(ctx context.Context, id string) (*QueueItem, error) {
+	str, err := q.r.HGet(ctx, q.kg.QueueItem(), id).Result()
+	if err == redis.Nil {
+		return nil, osqueue.ErrAdminItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading queue item: %w", err)
+	}
+	qi := &QueueItem{}
+	if err := json.Unmarshal([]byte(str), qi); err != nil {
+		return nil, fmt.Errorf("error reading queue item: %w", err)
+	}
+	return qi, nil
+}
+
+// items loads the given queue items, skipping items which have been removed or
+// which can't be read.
+func (q *queue) items(ctx context.Context, ids []string) ([]QueueItem, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	encoded, err := q.r.HMGet(ctx, q.kg.QueueItem(), ids...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error reading queue items: %w", err)
+	}
+	items := []QueueItem{}
+	for _, val := range encoded {
+		str, ok := val.(string)
+		if !ok {
+			continue
+		}
+		qi := QueueItem{}
+		if err := json.Unmarshal([]byte(str), &qi); err != nil {
+			// Unreadable items are dead-lettered when processing the queue.
+			continue
+		}
+		items = append(items, qi)
+	}
+	return items, nil
+}
+
+// adminErr maps the queue's errors to those returned by the osqueue.Admin interface.
+func adminErr(err error) error {
+	switch err {
+	case ErrQueueItemNotFound:
+		return osqueue.ErrAdminItemNotFound
+	case ErrQueueItemAlreadyLeased:
+		return osqueue.ErrAdminItemLeased
+	}
+	return err
+}
+
+func queuedItem(qi QueueItem) osqueue.QueuedItem {
+	return osqueue.QueuedItem{
+		ID:         qi.ID,
+		WorkflowID: qi.WorkflowID,
+		At:         time.UnixMilli(qi.AtMS),
+		Item:       qi.Data,
+		LeaseID:    qi.LeaseID,
+	}
+}
+
+func lease(kind, id string, leaseID ulid.ULID) osqueue.Lease {
+	return osqueue.Lease{
+		Kind:    kind,
+		ID:      id,
+		LeaseID: leaseID,
+		Expires: ulid.Time(leaseID.Time()),
+	}
+}
@@ -0,0 +1,151 @@
+package redis_state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueAdmin(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 100})
+	defer rc.Close()
+	q := NewQueue(rc)
+	ctx := context.Background()
+
+	var _ osqueue.Admin = q
+
+	idA, idB := uuid.New(), uuid.New()
+	start := time.Now().Truncate(time.Second)
+
+	a1, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: idA, Data: osqueue.Item{Kind: osqueue.KindEdge}}, start)
+	require.NoError(t, err)
+	a2, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: idA, Data: osqueue.Item{Kind: osqueue.KindEdge}}, start.Add(time.Minute))
+	require.NoError(t, err)
+	b1, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: idB, Data: osqueue.Item{Kind: osqueue.KindPause}}, start.Add(time.Hour))
+	require.NoError(t, err)
+
+	t.Run("It should list partitions ordered by their next item", func(t *testing.T) {
+		partitions, err := q.Partitions(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, partitions, 2)
+
+		require.Equal(t, idA, partitions[0].WorkflowID)
+		require.Equal(t, PriorityDefault, partitions[0].Priority)
+		require.Equal(t, start.Unix(), partitions[0].At.Unix())
+		require.EqualValues(t, 2, partitions[0].Len)
+		require.Nil(t, partitions[0].LeaseID)
+
+		require.Equal(t, idB, partitions[1].WorkflowID)
+		require.EqualValues(t, 1, partitions[1].Len)
+
+		partitions, err = q.Partitions(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, partitions, 1)
+	})
+
+	t.Run("It should peek items for a function, including future items", func(t *testing.T) {
+		items, err := q.PartitionItems(ctx, idA, 10)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		require.Equal(t, a1.ID, items[0].ID)
+		require.Equal(t, a2.ID, items[1].ID)
+		require.Equal(t, start.Add(time.Minute).UnixMilli(), items[1].At.UnixMilli())
+		require.Equal(t, osqueue.KindEdge, items[0].Item.Kind)
+	})
+
+	t.Run("It should not modify unreadable items when peeking", func(t *testing.T) {
+		idC := uuid.New()
+		r.HSet(defaultQueueKey.QueueItem(), "unreadable", "{")
+		_, err := r.ZAdd(defaultQueueKey.QueueIndex(idC.String()), float64(start.UnixMilli()), "unreadable")
+		require.NoError(t, err)
+
+		items, err := q.PartitionItems(ctx, idC, 10)
+		require.NoError(t, err)
+		require.Len(t, items, 0)
+		require.Equal(t, "{", r.HGet(defaultQueueKey.QueueItem(), "unreadable"))
+
+		r.HDel(defaultQueueKey.QueueItem(), "unreadable")
+		r.Del(defaultQueueKey.QueueIndex(idC.String()))
+	})
+
+	t.Run("It should reprioritize partitions", func(t *testing.T) {
+		err := q.ReprioritizePartition(ctx, idB, PriorityMax)
+		require.NoError(t, err)
+
+		partitions, err := q.Partitions(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, PriorityMax, partitions[1].Priority)
+
+		err = q.ReprioritizePartition(ctx, uuid.New(), PriorityMax)
+		require.Equal(t, osqueue.ErrAdminPartitionNotFound, err)
+
+		err = q.ReprioritizePartition(ctx, idB, PriorityMin+1)
+		require.Equal(t, ErrPriorityTooLow, err)
+	})
+
+	t.Run("It should show lease holders", func(t *testing.T) {
+		seqLease, err := q.LeaseSequential(ctx, time.Second)
+		require.NoError(t, err)
+		partitionLease, err := q.PartitionLease(ctx, idA, time.Second)
+		require.NoError(t, err)
+		itemLease, err := q.Lease(ctx, a1, 5*time.Second)
+		require.NoError(t, err)
+		// Extended leases are still shown.
+		itemLease, err = q.ExtendLease(ctx, a1, *itemLease, 5*time.Second)
+		require.NoError(t, err)
+
+		leases, err := q.Leases(ctx, 10)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []osqueue.Lease{
+			lease(osqueue.LeaseKindSequential, "", *seqLease),
+			lease(osqueue.LeaseKindPartition, idA.String(), *partitionLease),
+			lease(osqueue.LeaseKindItem, a1.ID, *itemLease),
+		}, leases)
+
+		// Leased items aren't returned when peeking.
+		items, err := q.PartitionItems(ctx, idA, 10)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		require.Equal(t, a2.ID, items[0].ID)
+	})
+
+	t.Run("It should not modify leased items", func(t *testing.T) {
+		err := q.DeleteItem(ctx, a1.ID)
+		require.Equal(t, osqueue.ErrAdminItemLeased, err)
+
+		err = q.RequeueItem(ctx, a1.ID, time.Now())
+		require.Equal(t, osqueue.ErrAdminItemLeased, err)
+
+		require.NotEmpty(t, r.HGet(defaultQueueKey.QueueItem(), a1.ID))
+	})
+
+	t.Run("It should requeue unleased items", func(t *testing.T) {
+		at := start.Add(2 * time.Hour)
+		err := q.RequeueItem(ctx, b1.ID, at)
+		require.NoError(t, err)
+
+		items, err := q.PartitionItems(ctx, idB, 10)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		require.Equal(t, at.UnixMilli(), items[0].At.UnixMilli())
+	})
+
+	t.Run("It should delete unleased items", func(t *testing.T) {
+		err := q.DeleteItem(ctx, a2.ID)
+		require.NoError(t, err)
+		require.Empty(t, r.HGet(defaultQueueKey.QueueItem(), a2.ID))
+
+		err = q.DeleteItem(ctx, a2.ID)
+		require.Equal(t, osqueue.ErrAdminItemNotFound, err)
+
+		err = q.RequeueItem(ctx, "nope", time.Now())
+		require.Equal(t, osqueue.ErrAdminItemNotFound, err)
+	})
+}
@@ -0,0 +1,190 @@
+package redis_state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	json "github.com/goccy/go-json"
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+)
+
+// deadLetter removes a permanently failed item from the queue, storing it within
+// the dead-letter queue with the error that failed the item.
+func (q *queue) deadLetter(ctx context.Context, i QueueItem, cause error) error {
+	data := i.Data
+	return q.storeDeadLetter(ctx, i, osqueue.DeadLetter{
+		ID:         i.ID,
+		WorkflowID: i.WorkflowID,
+		Item:       &data,
+		Error:      cause.Error(),
+		Attempt:    i.Data.Attempt,
+		At:         time.UnixMilli(i.AtMS),
+		FailedAt:   time.Now(),
+	})
+}
+
+// deadLetterRaw moves an item which can't be unmarshalled to the dead-letter queue,
+// storing the item as it was read from the queue.
+func (q *queue) deadLetterRaw(ctx context.Context, workflowID uuid.UUID, id string, raw string, cause error) error {
+	return q.storeDeadLetter(ctx, QueueItem{ID: id, WorkflowID: workflowID}, osqueue.DeadLetter{
+		ID:         id,
+		WorkflowID: workflowID,
+		Raw:        raw,
+		Error:      fmt.Sprintf("error unmarshalling queue item: %s", cause),
+		FailedAt:   time.Now(),
+	})
+}
+
+func (q *queue) storeDeadLetter(ctx context.Context, i QueueItem, dl osqueue.DeadLetter) error {
+	byt, err := json.Marshal(dl)
+	if err != nil {
+		return fmt.Errorf("error marshalling dead letter: %w", err)
+	}
+
+	concurrencyKey, _ := q.concurrency(i)
+	keys := []string{
+		q.kg.QueueItem(),
+		q.kg.QueueIndex(i.WorkflowID.String()),
+		q.kg.PartitionMeta(i.WorkflowID.String()),
+		q.kg.Idempotency(i.ID),
+		concurrencyKey,
+		q.kg.DeadLetterItem(),
+		q.kg.DeadLetterIndex(),
+	}
+	status, err := scripts["queue/deadLetter"].Run(
+		ctx,
+		q.r,
+		keys,
+
+		i.ID,
+		int(q.idempotencyTTL.Seconds()),
+		string(byt),
+		dl.FailedAt.UnixMilli(),
+	).Int64()
+	if err != nil {
+		return fmt.Errorf("error dead-lettering item: %w", err)
+	}
+	switch status {
+	case 0:
+		return nil
+	case 1:
+		return ErrQueueItemNotFound
+	default:
+		return fmt.Errorf("unknown response dead-lettering item: %d", status)
+	}
+}
+
+// DeadLetters returns up to limit dead letters, most recently failed first.
+func (q *queue) DeadLetters(ctx context.Context, limit int64) ([]osqueue.DeadLetter, error) {
+	if limit <= 0 {
+		limit = QueuePeekMax
+	}
+
+	ids, err := q.r.ZRevRange(ctx, q.kg.DeadLetterIndex(), 0, limit-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error reading dead letters: %w", err)
+	}
+	if len(ids) == 0 {
+		return []osqueue.DeadLetter{}, nil
+	}
+
+	vals, err := q.r.HMGet(ctx, q.kg.DeadLetterItem(), ids...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error reading dead letters: %w", err)
+	}
+
+	result := make([]osqueue.DeadLetter, 0, len(vals))
+	for _, val := range vals {
+		str, ok := val.(string)
+		if !ok {
+			// Purged since reading the index.
+			continue
+		}
+		dl := osqueue.DeadLetter{}
+		if err := json.Unmarshal([]byte(str), &dl); err != nil {
+			return nil, fmt.Errorf("error unmarshalling dead letter: %w", err)
+		}
+		result = append(result, dl)
+	}
+	return result, nil
+}
+
+// DeadLetter returns a single dead letter by its ID.
+func (q *queue) DeadLetter(ctx context.Context, id string) (*osqueue.DeadLetter, error) {
+	str, err := q.r.HGet(ctx, q.kg.DeadLetterItem(), id).Result()
+	if err == redis.Nil {
+		return nil, osqueue.ErrDeadLetterNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading dead letter: %w", err)
+	}
+	dl := &osqueue.DeadLetter{}
+	if err := json.Unmarshal([]byte(str), dl); err != nil {
+		return nil, fmt.Errorf("error unmarshalling dead letter: %w", err)
+	}
+	return dl, nil
+}
+
+// RequeueDeadLetter enqueues the dead letter's item to run immediately with its
+// attempts reset, atomically removing the dead letter.
+//
+// The item is enqueued with a new ID, as the failed item's ID is still held for
+// idempotency.
+func (q *queue) RequeueDeadLetter(ctx context.Context, id string) error {
+	dl, err := q.DeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+	if dl.Item == nil {
+		return osqueue.ErrDeadLetterUnreadable
+	}
+
+	item := *dl.Item
+	item.Attempt = 0
+	_, err = q.enqueue(ctx, QueueItem{
+		WorkflowID:  dl.WorkflowID,
+		WorkspaceID: item.WorkspaceID,
+		Data:        item,
+	}, time.Now(), id)
+	if err == osqueue.ErrDeadLetterNotFound {
+		// The dead letter was requeued or purged since being read.
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("error requeueing dead letter: %w", err)
+	}
+	return nil
+}
+
+// PurgeDeadLetters removes the given dead letters, or every dead letter if no IDs
+// are given, returning the number of dead letters removed.
+func (q *queue) PurgeDeadLetters(ctx context.Context, ids ...string) (int64, error) {
+	if len(ids) == 0 {
+		pipe := q.r.TxPipeline()
+		n := pipe.HLen(ctx, q.kg.DeadLetterItem())
+		pipe.Del(ctx, q.kg.DeadLetterItem(), q.kg.DeadLetterIndex())
+		if _, err := pipe.Exec(ctx); err != nil {
+			return 0, fmt.Errorf("error purging dead letters: %w", err)
+		}
+		return n.Val(), nil
+	}
+
+	pipe := q.r.TxPipeline()
+	n := pipe.HDel(ctx, q.kg.DeadLetterItem(), ids...)
+	pipe.ZRem(ctx, q.kg.DeadLetterIndex(), toInterfaces(ids)...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("error purging dead letters: %w", err)
+	}
+	return n.Val(), nil
+}
+
+func toInterfaces(s []string) []interface{} {
+	result := make([]interface{}, len(s))
+	for n, v := range s {
+		result[n] = v
+	}
+	return result
+}
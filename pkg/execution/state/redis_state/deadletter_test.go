@@ -0,0 +1,151 @@
+package redis_state
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueDeadLetter(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 100})
+	defer rc.Close()
+	q := NewQueue(rc)
+	ctx := context.Background()
+
+	wid := uuid.New()
+	start := time.Now().Truncate(time.Millisecond)
+
+	item, err := q.EnqueueItem(ctx, QueueItem{
+		WorkflowID: wid,
+		Data:       osqueue.Item{Kind: osqueue.KindEdge, Attempt: 2},
+	}, start)
+	require.NoError(t, err)
+
+	_, err = q.Lease(ctx, item, time.Second)
+	require.NoError(t, err)
+
+	err = q.deadLetter(ctx, item, fmt.Errorf("permanently failed"))
+	require.NoError(t, err)
+
+	t.Run("It should remove the item from the queue", func(t *testing.T) {
+		require.Empty(t, r.HGet(defaultQueueKey.QueueItem(), item.ID))
+
+		items, err := q.Peek(ctx, wid, time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, len(items))
+
+		val := r.HGet(defaultQueueKey.PartitionMeta(wid.String()), "n")
+		require.Equal(t, "0", val)
+	})
+
+	t.Run("It should store the failed item with its error", func(t *testing.T) {
+		dl, err := q.DeadLetter(ctx, item.ID)
+		require.NoError(t, err)
+		require.Equal(t, item.ID, dl.ID)
+		require.Equal(t, wid, dl.WorkflowID)
+		require.NotNil(t, dl.Item)
+		require.Equal(t, osqueue.KindEdge, dl.Item.Kind)
+		require.Equal(t, "permanently failed", dl.Error)
+		require.Equal(t, 2, dl.Attempt)
+		require.Equal(t, start.UnixMilli(), dl.At.UnixMilli())
+		require.False(t, dl.FailedAt.IsZero())
+
+		_, err = q.DeadLetter(ctx, "nope")
+		require.Equal(t, osqueue.ErrDeadLetterNotFound, err)
+	})
+
+	t.Run("It should dead-letter items which can't be unmarshalled when processing", func(t *testing.T) {
+		bad, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: wid}, start.Add(time.Millisecond))
+		require.NoError(t, err)
+		r.HSet(defaultQueueKey.QueueItem(), bad.ID, "{invalid")
+
+		// Peeking is read-only and leaves the item in place.
+		items, err := q.Peek(ctx, wid, time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, len(items))
+		require.Equal(t, "{invalid", r.HGet(defaultQueueKey.QueueItem(), bad.ID))
+		_, err = q.DeadLetter(ctx, bad.ID)
+		require.Equal(t, osqueue.ErrDeadLetterNotFound, err)
+
+		err = q.processPartition(ctx, &QueuePartition{WorkflowID: wid}, func(context.Context, osqueue.Item) error {
+			return nil
+		})
+		require.NoError(t, err)
+		require.Empty(t, r.HGet(defaultQueueKey.QueueItem(), bad.ID))
+
+		dl, err := q.DeadLetter(ctx, bad.ID)
+		require.NoError(t, err)
+		require.Nil(t, dl.Item)
+		require.Equal(t, "{invalid", dl.Raw)
+
+		err = q.RequeueDeadLetter(ctx, bad.ID)
+		require.Equal(t, osqueue.ErrDeadLetterUnreadable, err)
+	})
+
+	t.Run("It should list dead letters, most recent first", func(t *testing.T) {
+		dls, err := q.DeadLetters(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(dls))
+		require.Nil(t, dls[0].Item)
+		require.Equal(t, item.ID, dls[1].ID)
+
+		dls, err = q.DeadLetters(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(dls))
+	})
+
+	t.Run("It should requeue dead letters with attempts reset", func(t *testing.T) {
+		err := q.RequeueDeadLetter(ctx, item.ID)
+		require.NoError(t, err)
+
+		_, err = q.DeadLetter(ctx, item.ID)
+		require.Equal(t, osqueue.ErrDeadLetterNotFound, err)
+
+		items, err := q.Peek(ctx, wid, time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(items))
+		require.NotEqual(t, item.ID, items[0].ID)
+		require.Equal(t, 0, items[0].Data.Attempt)
+		require.Equal(t, osqueue.KindEdge, items[0].Data.Kind)
+
+		// Requeueing a dead letter which no longer exists enqueues nothing.
+		err = q.RequeueDeadLetter(ctx, item.ID)
+		require.Equal(t, osqueue.ErrDeadLetterNotFound, err)
+		_, err = q.enqueue(ctx, QueueItem{WorkflowID: wid}, time.Now(), item.ID)
+		require.Equal(t, osqueue.ErrDeadLetterNotFound, err)
+
+		items, err = q.Peek(ctx, wid, time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(items))
+	})
+
+	t.Run("It should purge dead letters", func(t *testing.T) {
+		other, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: wid}, start)
+		require.NoError(t, err)
+		require.NoError(t, q.deadLetter(ctx, other, fmt.Errorf("failed")))
+
+		dls, err := q.DeadLetters(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(dls))
+
+		n, err := q.PurgeDeadLetters(ctx, other.ID, "nope")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+
+		n, err = q.PurgeDeadLetters(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+
+		dls, err = q.DeadLetters(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(dls))
+	})
+}
@@ -31,6 +31,10 @@ type KeyGenerator interface {
 	// given workflow run.
 	Event(context.Context, state.Identifier) string
 
+	// Batch returns the key used to store the batch of events for the given
+	// workflow run, if the run was started by a batch trigger.
+	Batch(context.Context, state.Identifier) string
+
 	// Actions returns the key used to store the action response map used
 	// for given workflow run - ie. the results for individual steps.
 	Actions(context.Context, state.Identifier) string
@@ -51,6 +55,18 @@ type KeyGenerator interface {
 	// PauseEvent returns the key used to store data for loading pauses by events.
 	PauseEvent(context.Context, uuid.UUID, string) string
 
+	// PauseEventMatch returns the key used to store pauses for an event which
+	// are indexed by the given match key.
+	PauseEventMatch(ctx context.Context, workspaceID uuid.UUID, event string, matchKey string) string
+
+	// PauseEventMatchKeys returns the key used to store the set of match keys
+	// with indexed pauses for an event.
+	PauseEventMatchKeys(ctx context.Context, workspaceID uuid.UUID, event string) string
+
+	// RunPauses returns the key used to store the keys of every pause for the
+	// given run, allowing all of a run's pauses to be deleted when the run ends.
+	RunPauses(ctx context.Context, runID ulid.ULID) string
+
 	// PauseStep returns the prefix of the key used within PauseStep.  This lets us
 	// iterate through all pauses for a given identifier
 	PauseStepPrefix(context.Context, state.Identifier) string
@@ -60,6 +76,22 @@ type KeyGenerator interface {
 
 	// History returns the key used to store a log entry for run hisotry
 	History(ctx context.Context, runID ulid.ULID) string
+
+	// Debounce returns the key used to store the pending debounce for the given
+	// debounce key.
+	Debounce(ctx context.Context, key string) string
+
+	// Reported returns the key used to store the set of reported generator steps
+	// for the given group of parallel steps.
+	Reported(ctx context.Context, runID ulid.ULID, groupID string) string
+
+	// FunctionRuns returns the key used to store the sorted set of a function's
+	// run IDs, scored by the time that each run started.
+	FunctionRuns(ctx context.Context, workflowID uuid.UUID) string
+
+	// ReceivedEvent returns the key used to record that the event with the
+	// given ID was received.
+	ReceivedEvent(ctx context.Context, eventID string) string
 }
 
 type DefaultKeyFunc struct {
@@ -82,6 +114,10 @@ func (d DefaultKeyFunc) Event(ctx context.Context, id state.Identifier) string {
 	return fmt.Sprintf("%s:events:%s:%s", d.Prefix, id.WorkflowID, id.RunID)
 }
 
+func (d DefaultKeyFunc) Batch(ctx context.Context, id state.Identifier) string {
+	return fmt.Sprintf("%s:batches:%s:%s", d.Prefix, id.WorkflowID, id.RunID)
+}
+
 func (d DefaultKeyFunc) Actions(ctx context.Context, id state.Identifier) string {
 	return fmt.Sprintf("%s:actions:%s:%s", d.Prefix, id.WorkflowID, id.RunID)
 }
@@ -90,6 +126,22 @@ func (d DefaultKeyFunc) Errors(ctx context.Context, id state.Identifier) string
 	return fmt.Sprintf("%s:errors:%s:%s", d.Prefix, id.WorkflowID, id.RunID)
 }
 
+func (d DefaultKeyFunc) Debounce(ctx context.Context, key string) string {
+	return fmt.Sprintf("%s:debounce:%s", d.Prefix, key)
+}
+
+func (d DefaultKeyFunc) Reported(ctx context.Context, runID ulid.ULID, groupID string) string {
+	return fmt.Sprintf("%s:reported:%s:%s", d.Prefix, runID, groupID)
+}
+
+func (d DefaultKeyFunc) FunctionRuns(ctx context.Context, workflowID uuid.UUID) string {
+	return fmt.Sprintf("%s:fn-runs:%s", d.Prefix, workflowID)
+}
+
+func (d DefaultKeyFunc) ReceivedEvent(ctx context.Context, eventID string) string {
+	return fmt.Sprintf("%s:received:%s", d.Prefix, eventID)
+}
+
 func (d DefaultKeyFunc) PauseID(ctx context.Context, id uuid.UUID) string {
 	return fmt.Sprintf("%s:pauses:%s", d.Prefix, id.String())
 }
@@ -102,6 +154,18 @@ func (d DefaultKeyFunc) PauseEvent(ctx context.Context, workspaceID uuid.UUID, e
 	return fmt.Sprintf("%s:pause-events:%s:%s", d.Prefix, workspaceID, event)
 }
 
+func (d DefaultKeyFunc) PauseEventMatch(ctx context.Context, workspaceID uuid.UUID, event string, matchKey string) string {
+	return fmt.Sprintf("%s:pause-events:%s:%s:match:%s", d.Prefix, workspaceID, event, matchKey)
+}
+
+func (d DefaultKeyFunc) PauseEventMatchKeys(ctx context.Context, workspaceID uuid.UUID, event string) string {
+	return fmt.Sprintf("%s:pause-event-matches:%s:%s", d.Prefix, workspaceID, event)
+}
+
+func (d DefaultKeyFunc) RunPauses(ctx context.Context, runID ulid.ULID) string {
+	return fmt.Sprintf("%s:pause-runs:%s", d.Prefix, runID)
+}
+
 func (d DefaultKeyFunc) PauseStepPrefix(ctx context.Context, id state.Identifier) string {
 	return fmt.Sprintf("%s:pause-steps:%s", d.Prefix, id.RunID)
 }
@@ -134,6 +198,21 @@ type QueueKeyGenerator interface {
 	Sequential() string
 	// Idempotency stores the map for storing idempotency keys in redis
 	Idempotency(key string) string
+	// Throttle returns the key for the sorted set storing reserved start times
+	// for the given throttle key.
+	Throttle(key string) string
+	// Concurrency returns the key for the sorted set storing leased items for
+	// the given concurrency key, scored by lease expiry.
+	Concurrency(key string) string
+	// DeadLetterItem returns the key for the hash containing all permanently
+	// failed queue items.
+	DeadLetterItem() string
+	// DeadLetterIndex returns the key for the sorted set of permanently failed
+	// queue items, scored by failure time.
+	DeadLetterIndex() string
+	// PausedFunctions returns the key for the set of paused function IDs,
+	// whose partitions must not be leased.
+	PausedFunctions() string
 }
 
 type DefaultQueueKeyGenerator struct {
@@ -167,3 +246,23 @@ func (d DefaultQueueKeyGenerator) Sequential() string {
 func (d DefaultQueueKeyGenerator) Idempotency(key string) string {
 	return fmt.Sprintf("%s:queue:seen:%s", d.Prefix, key)
 }
+
+func (d DefaultQueueKeyGenerator) Throttle(key string) string {
+	return fmt.Sprintf("%s:throttle:%s", d.Prefix, key)
+}
+
+func (d DefaultQueueKeyGenerator) Concurrency(key string) string {
+	return fmt.Sprintf("%s:concurrency:%s", d.Prefix, key)
+}
+
+func (d DefaultQueueKeyGenerator) DeadLetterItem() string {
+	return fmt.Sprintf("%s:dead-letter:item", d.Prefix)
+}
+
+func (d DefaultQueueKeyGenerator) DeadLetterIndex() string {
+	return fmt.Sprintf("%s:dead-letter:sorted", d.Prefix)
+}
+
+func (d DefaultQueueKeyGenerator) PausedFunctions() string {
+	return fmt.Sprintf("%s:queue:paused", d.Prefix)
+}
@@ -8,7 +8,16 @@ Output:
 
 ]]
 
-local metadataKey = KEYS[1]
+local metadataKey  = KEYS[1]
+local runPausesKey = KEYS[2]
+local historyKey   = KEYS[3]
+local fnRunsKey    = KEYS[4]
+
+local funcLog = ARGV[1]
+local logTime = tonumber(ARGV[2])
+local runID   = ARGV[3]
+
+-- $include(delete_run_pauses.lua)
 
 local value = tonumber(redis.call("HGET", metadataKey, "status"))
 if value ~= 0 then
@@ -17,4 +26,8 @@ if value ~= 0 then
 end
 
 redis.call("HSET", metadataKey, "status", 3)
+redis.call("ZADD", historyKey, logTime, funcLog)
+redis.call("ZREM", fnRunsKey, runID)
+-- Cancelled runs can no longer be resumed, so remove all outstanding pauses.
+delete_run_pauses(runPausesKey)
 return 0;
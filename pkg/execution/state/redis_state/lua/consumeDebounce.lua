@@ -0,0 +1,24 @@
+--[[
+
+Consumes a debounce if it hasn't been replaced by a newer event.
+
+Output:
+  The consumed debounce, or nil if the debounce was not found or replaced.
+
+]]
+
+local debounceKey = KEYS[1]
+local debounceID  = ARGV[1]
+
+local debounce = redis.call("GET", debounceKey)
+if debounce == false or debounce == nil then
+	return nil
+end
+
+if cjson.decode(debounce).id ~= debounceID then
+	-- This debounce has been replaced by a newer event.
+	return nil
+end
+
+redis.call("DEL", debounceKey)
+return debounce
@@ -13,10 +13,13 @@ local pauseKey      = KEYS[1]
 local pauseStepKey  = KEYS[2]
 local pauseEventKey = KEYS[3]
 local actionKey     = KEYS[4]
+local matchKeysKey  = KEYS[5] -- set of match keys for the pause's event, if indexed
+local runPausesKey  = KEYS[6]
 
 local pauseID      = ARGV[1]
 local pauseDataKey = ARGV[2] -- used to set data in run state store
 local pauseDataVal = ARGV[3] -- data to set
+local matchKey     = ARGV[4] -- the pause's match key, if indexed
 
 local pause = redis.call("GET", pauseKey)
 if pause == false or pause == nil then
@@ -26,9 +29,13 @@ end
 
 redis.call("DEL", pauseKey)
 redis.call("DEL", pauseStepKey)
+redis.call("HDEL", runPausesKey, pauseID)
 
 if pauseEventKey ~= "" then
 	redis.call("HDEL", pauseEventKey, pauseID)
+	if matchKeysKey ~= "" and redis.call("HLEN", pauseEventKey) == 0 then
+		redis.call("SREM", matchKeysKey, matchKey)
+	end
 end
 
 if actionKey ~= nil and pauseDataKey ~= "" and pauseDataVal ~= nil then
@@ -6,22 +6,32 @@ Output:
 
 ]]
 
-local metadataKey = KEYS[1]
-local historyKey  = KEYS[2]
+local metadataKey  = KEYS[1]
+local historyKey   = KEYS[2]
+local runPausesKey = KEYS[3]
+local fnRunsKey    = KEYS[4]
 
 local funcLog = ARGV[1]
 local logTime = tonumber(ARGV[2])
 local status  = tonumber(ARGV[3])
+local runID   = ARGV[4]
+
+-- $include(delete_run_pauses.lua)
 
 if redis.call("HINCRBY", metadataKey, "pending", -1) ~= 0 then
 	return 0;
 end
 
+-- The run has no outstanding steps, so remove all pauses such as cancellation
+-- pauses.
+delete_run_pauses(runPausesKey)
+
 if status ~= -1 then
 	-- If status isn't -1 then we're forcing a status, eg. if Finalized was called
 	-- with an error to mark the step as Failed.
 	redis.call("HSET", metadataKey, "status", status)
 	redis.call("ZADD", historyKey, logTime, funcLog)
+	redis.call("ZREM", fnRunsKey, runID)
 	return 1;
 end
 
@@ -29,6 +39,7 @@ end
 if tonumber(redis.call("HGET", metadataKey, "status")) == 0 then
 	redis.call("HSET", metadataKey, "status", 1)
 	redis.call("ZADD", historyKey, logTime, funcLog)
+	redis.call("ZREM", fnRunsKey, runID)
 	return 1;
 end
 
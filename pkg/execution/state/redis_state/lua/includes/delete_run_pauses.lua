@@ -0,0 +1,18 @@
+--- delete_run_pauses deletes every pause stored within the given run's pause
+--- hash, including references to each pause from the pause's event.
+local function delete_run_pauses(runPausesKey)
+	local pauses = redis.call("HGETALL", runPausesKey)
+	for i = 1, #pauses, 2 do
+		local pauseID = pauses[i]
+		local keys = cjson.decode(pauses[i+1])
+
+		redis.call("DEL", keys.pause, keys.step, keys.lease)
+		if keys.event ~= "" then
+			redis.call("HDEL", keys.event, pauseID)
+			if keys.matchKeys ~= "" and redis.call("HLEN", keys.event) == 0 then
+				redis.call("SREM", keys.matchKeys, keys.matchKey)
+			end
+		end
+	end
+	redis.call("DEL", runPausesKey)
+end
@@ -12,6 +12,8 @@ local workflowKey = KEYS[3]
 local metadataKey = KEYS[4]
 local stepKey = KEYS[5]
 local logKey = KEYS[6]
+local batchKey = KEYS[7]
+local fnRunsKey = KEYS[8]
 
 local event = ARGV[1]
 local workflow = ARGV[2]
@@ -20,17 +22,30 @@ local steps = ARGV[4]
 local expiry = tonumber(ARGV[5])
 local log = ARGV[6]
 local logScore = tonumber(ARGV[7])
+local skipped = tonumber(ARGV[8])
+local idempotencyTTL = tonumber(ARGV[9]) -- in milliseconds
+local batch = ARGV[10]
+local runID = ARGV[11]
+local expiredBefore = tonumber(ARGV[12]) -- in milliseconds, or 0 if state never expires
 
-if redis.call("SETNX", idempotencyKey, "") == 0 then
+if skipped == 1 then
+  -- Skipped runs are stored for visibility only and never claim the idempotency
+  -- key, but must still have a unique run ID.
+  if redis.call("EXISTS", metadataKey) == 1 then
+    return 1
+  end
+elseif redis.call("SETNX", idempotencyKey, "") == 0 then
   -- If this key exists, everything must've been initialised, so we can exit early
   return 1
+elseif idempotencyTTL > 0 then
+  redis.call("PEXPIRE", idempotencyKey, idempotencyTTL)
 end
 
 redis.call("SETNX", workflowKey, workflow)
 
 local metadataJson = cjson.decode(metadata)
 for k, v in pairs(metadataJson) do
-  if k == "ctx" or k == "id" then
+  if k == "ctx" or k == "id" or k == "eventIDs" then
 	  v = cjson.encode(v)
   end
   redis.call("HSET", metadataKey, k, tostring(v))
@@ -45,14 +60,29 @@ if steps ~= nil and steps ~= "" then
 end
 
 redis.call("SETNX", eventKey, event)
+if batch ~= nil and batch ~= "" then
+  redis.call("SETNX", batchKey, batch)
+end
 redis.call("ZADD", logKey, logScore, log)
+if skipped ~= 1 then
+  -- Index the run by its function so that running runs can be listed.  The
+  -- log score is the time that the run started.
+  redis.call("ZADD", fnRunsKey, logScore, runID)
+  -- Remove runs whose state has expired, as finished runs are otherwise only
+  -- removed when they finish.
+  if expiredBefore > 0 then
+    redis.call("ZREMRANGEBYSCORE", fnRunsKey, "-inf", "(" .. ARGV[12])
+  end
+end
 
 if expiry > 0 then
   redis.call("EXPIRE", workflowKey, expiry)
   redis.call("EXPIRE", metadataKey, expiry)
   redis.call("EXPIRE", stepKey, expiry)
   redis.call("EXPIRE", eventKey, expiry)
+  redis.call("EXPIRE", batchKey, expiry)
   redis.call("EXPIRE", logKey, expiry)
+  redis.call("EXPIRE", fnRunsKey, expiry)
 end
 
 return 0
@@ -0,0 +1,49 @@
+--[[
+
+Removes a permanently failed item from the queue, storing it within the
+dead-letter queue.
+
+Output:
+  0: Successfully moved item to the dead-letter queue
+  1: Queue item not found
+
+]]
+
+local queueKey           = KEYS[1]
+local queueIndexKey      = KEYS[2]
+local partitionKey       = KEYS[3]
+local idempotencyKey     = KEYS[4]
+local concurrencyKey     = KEYS[5]
+local deadLetterKey      = KEYS[6] -- dead-letter:item - hash: { $itemID: $deadLetter }
+local deadLetterIndexKey = KEYS[7] -- dead-letter:sorted - zset, scored by failure time
+
+local queueID        = ARGV[1]
+local idempotencyTTL = tonumber(ARGV[2])
+local deadLetter     = ARGV[3]
+local failedAt       = tonumber(ARGV[4])
+
+-- The item may not be valid JSON if it couldn't be unmarshalled, so read it
+-- without decoding.
+local fetched = redis.call("HGET", queueKey, queueID)
+if fetched == false then
+	return 1
+end
+
+redis.call("HDEL", queueKey, queueID)
+redis.call("ZREM", queueIndexKey, queueID)
+redis.call("HINCRBY", partitionKey, "len", -1) -- len of enqueued items decreases
+redis.call("SETEX", idempotencyKey, idempotencyTTL, "")
+
+local ok, item = pcall(cjson.decode, fetched)
+if ok and type(item) == "table" and item.leaseID ~= nil and item.leaseID ~= cjson.null then
+	-- Remove total number in progress, if there's a lease.
+	redis.call("HINCRBY", partitionKey, "n", -1)
+end
+
+-- Free the item's capacity within its concurrency limit, if any.
+redis.call("ZREM", concurrencyKey, queueID)
+
+redis.call("HSET", deadLetterKey, queueID, deadLetter)
+redis.call("ZADD", deadLetterIndexKey, failedAt, queueID)
+
+return 0
@@ -3,6 +3,7 @@
 Output:
   0: Successfully dequeued item
   1: Queue item not found
+  2: Queue item is leased
 
 ]]
 
@@ -10,17 +11,25 @@ local queueKey       = KEYS[1]
 local queueIndexKey  = KEYS[2]
 local partitionKey   = KEYS[3]
 local idempotencyKey = KEYS[4]
+local concurrencyKey = KEYS[5]
 
 local queueID = ARGV[1]
 local idempotencyTTL = tonumber(ARGV[2])
+local unleasedAt     = tonumber(ARGV[3]) -- in ms;  if > 0, leased items aren't dequeued
 
 -- $include(get_queue_item.lua)
+-- $include(decode_ulid_time.lua)
 -- Fetch this item to see if it was in progress prior to deleting.
 local item = get_queue_item(queueKey, queueID)
 if item == nil then
 	return 1
 end
 
+if unleasedAt > 0 and item.leaseID ~= nil and item.leaseID ~= cjson.null and decode_ulid_time(item.leaseID) > unleasedAt then
+	-- The item is being worked on;  leave it in the queue.
+	return 2
+end
+
 redis.call("HDEL", queueKey, queueID)
 redis.call("ZREM", queueIndexKey, queueID)
 redis.call("HINCRBY", partitionKey, "len", -1) -- len of enqueued items decreases
@@ -31,4 +40,7 @@ if item.leaseID ~= nil and item.leaseID ~= cjson.null then
 	redis.call("HINCRBY", partitionKey, "n", -1)
 end
 
+-- Free the item's capacity within its concurrency limit, if any.
+redis.call("ZREM", concurrencyKey, queueID)
+
 return 0
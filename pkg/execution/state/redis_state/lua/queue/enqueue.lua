@@ -2,6 +2,12 @@
 
 Enqueus an item within the queue.
 
+If a dead letter ID is given, the dead letter is removed when the item is enqueued.
+
+Output:
+  0: Successfully enqueued item
+  1: Queue item already exists
+  2: Dead letter not found
 
 --]]
 
@@ -11,12 +17,19 @@ local partitionKey        = KEYS[3]   -- partition:item - hash: { $workflowID: $
 local partitionCounterKey = KEYS[4]   -- partition:item:$workflowID - hash: { "n": items leased/in-progress, "len": total enqueued }
 local partitionIndexKey   = KEYS[5]   -- partition:sorted - zset
 local idempotencyKey      = KEYS[6]   -- seen:$key
+local deadLetterKey       = KEYS[7]   -- dead-letter:item - hash: { $itemID: $deadLetter }
+local deadLetterIndexKey  = KEYS[8]   -- dead-letter:sorted - zset
 
 local queueItem      = ARGV[1] -- {id, lease id, attempt, max attempt, data, etc...}
 local queueID        = ARGV[2] -- id
 local queueScore     = tonumber(ARGV[3]) -- vesting time, in seconds
 local workflowID     = ARGV[4] -- $workflowID
 local partitionItem  = ARGV[5] -- {workflow, priority, leasedAt, etc}
+local deadLetterID   = ARGV[6] -- the dead letter being requeued, or ""
+
+if deadLetterID ~= "" and redis.call("HEXISTS", deadLetterKey, deadLetterID) == 0 then
+	return 2
+end
 
 -- Check idempotency exists
 if redis.call("EXISTS", idempotencyKey) ~= 0 then
@@ -54,6 +67,11 @@ if currentScore == false or tonumber(currentScore) > partitionScore then
 	redis.call("HSET", partitionKey, workflowID, partitionItem)
 end
 
+if deadLetterID ~= "" then
+	redis.call("HDEL", deadLetterKey, deadLetterID)
+	redis.call("ZREM", deadLetterIndexKey, deadLetterID)
+end
+
 -- TODO: For the given workflow ID increase scheduled count, store a history item,
 -- etc:  this can be atomic in the redis queue as it combines state + queue.
 
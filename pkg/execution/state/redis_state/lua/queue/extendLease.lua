@@ -8,12 +8,14 @@ Output:
 
 ]]
 
-local queueKey      = KEYS[1]
-local queueIndexKey = KEYS[2]
+local queueKey       = KEYS[1]
+local queueIndexKey  = KEYS[2]
+local concurrencyKey = KEYS[3]
 
-local queueID         = ARGV[1]
-local currentLeaseKey = ARGV[2]
-local newLeaseKey     = ARGV[3]
+local queueID          = ARGV[1]
+local currentLeaseKey  = ARGV[2]
+local newLeaseKey      = ARGV[3]
+local concurrencyLimit = tonumber(ARGV[4])
 
 -- $include(decode_ulid_time.lua)
 -- $include(get_queue_item.lua)
@@ -37,6 +39,11 @@ item.leaseID = newLeaseKey
 -- Update the item's lease key.
 redis.call("HSET", queueKey, queueID, cjson.encode(item))
 -- Update the item's score in our sorted index.
-redis.call("ZADD", queueIndexKey, math.floor(nextTime / 1000), item.id)
+redis.call("ZADD", queueIndexKey, nextTime, item.id)
+
+if concurrencyLimit > 0 then
+	-- Extend the item's lease within the concurrency limit.
+	redis.call("ZADD", concurrencyKey, nextTime, item.id)
+end
 
 return 0
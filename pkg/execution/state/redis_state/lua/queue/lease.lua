@@ -4,16 +4,20 @@ Output:
   0: Successfully leased item
   1: Queue item not found
   2: Queue item already leased
+  3: Concurrency limit reached
 
 ]]
 
-local queueKey      = KEYS[1]
-local queueIndexKey = KEYS[2]
-local partitionKey  = KEYS[3]
+local queueKey       = KEYS[1]
+local queueIndexKey  = KEYS[2]
+local partitionKey   = KEYS[3]
+local concurrencyKey = KEYS[4] -- concurrency:$key - zset: { $itemID: $leaseExpiry }
 
-local queueID       = ARGV[1]
-local newLeaseKey   = ARGV[2]
-local currentTime   = tonumber(ARGV[3]) -- in ms
+local queueID          = ARGV[1]
+local newLeaseKey      = ARGV[2]
+local currentTime      = tonumber(ARGV[3]) -- in ms
+local concurrencyLimit = tonumber(ARGV[4]) -- 0 if the item has no concurrency limit
+local limitedUntil     = tonumber(ARGV[5]) -- in ms;  the latest time to requeue limited items until
 
 -- Use our custom Go preprocessor to inject the file from ./includes/
 -- $include(decode_ulid_time.lua)
@@ -37,6 +41,25 @@ if item.leaseID ~= nil and item.leaseID ~= cjson.null and decode_ulid_time(item.
 	return 2
 end
 
+if concurrencyLimit > 0 then
+	-- Remove leases which have expired, eg. from dead workers, so that they
+	-- no longer count towards the limit.
+	redis.call("ZREMRANGEBYSCORE", concurrencyKey, "-inf", currentTime)
+	if redis.call("ZSCORE", concurrencyKey, queueID) == false and redis.call("ZCARD", concurrencyKey) >= concurrencyLimit then
+		-- Leave the item in the queue until capacity may be available:  when
+		-- the earliest lease expires, or earlier as running items may finish
+		-- before their leases expire.  This stops the item from being peeked
+		-- repeatedly, blocking available items behind it.
+		local earliest = redis.call("ZRANGE", concurrencyKey, 0, 0, "WITHSCORES")
+		if #earliest > 0 and tonumber(earliest[2]) < limitedUntil then
+			limitedUntil = tonumber(earliest[2])
+		end
+		redis.call("ZADD", queueIndexKey, limitedUntil, queueID)
+		return 3
+	end
+	redis.call("ZADD", concurrencyKey, nextTime, queueID)
+end
+
 if item.leaseID == nil or item.leaseID == cjson.null then
 	-- Increase the in-progress count by 1 as we've just leased an item.
 	-- This lets us calculate the number of concurrent items when multiple shared-nothing
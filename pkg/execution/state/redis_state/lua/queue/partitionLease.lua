@@ -4,16 +4,19 @@ Output:
   0: Successfully leased item
   1: Partition item not found
   2: Partition item already leased
+  3: Partition's function is paused
 
 ]]
 
 local partitionKey      = KEYS[1]
 local partitionIndexKey = KEYS[2]
+local pausedKey         = KEYS[3] -- set of paused function IDs
 
 local partitionID = ARGV[1]
 local leaseID     = ARGV[2]
 local currentTime = tonumber(ARGV[3]) -- in ms, to check lease validation
 local leaseTime   = tonumber(ARGV[4]) -- in seconds, as partition score
+local pausedTime  = tonumber(ARGV[5]) -- in seconds, as partition score if paused
 
 -- $include(get_partition_item.lua)
 -- $include(decode_ulid_time.lua)
@@ -23,6 +26,13 @@ if existing == nil or existing == false then
 	return 1
 end
 
+if redis.call("SISMEMBER", pausedKey, partitionID) == 1 then
+	-- Push the partition back so that scanners don't continually attempt
+	-- to lease the paused function's partition.
+	redis.call("ZADD", partitionIndexKey, pausedTime, partitionID)
+	return 3
+end
+
 -- Check for an existing lease.
 if existing.leaseID ~= nil and existing.leaseID ~= cjson.null and decode_ulid_time(existing.leaseID) > currentTime then
 	return 2
@@ -37,17 +37,23 @@ if tonumber(redis.call("ZCARD", queueIndex)) == 0 then
 end
 
 -- Peek up to N items from the workflow.
-local items = redis.call("ZRANGE", queueIndex, "-inf", "+inf", "BYSCORE", "LIMIT", 0, peekLimit)
+local items = redis.call("ZRANGE", queueIndex, "-inf", "+inf", "BYSCORE", "LIMIT", 0, peekLimit, "WITHSCORES")
 
 if #items > 0 then
 	-- Take the earliest time available here, and check if it's smaller than
-	-- the partition.
-	-- TODO: We should really only take the non-leased items to reduce waste.
-	local encoded = redis.call("HMGET", queueKey, unpack(items))
-	for k, v in pairs(encoded) do
-		local item = cjson.decode(v)
-		if (item.leaseID == nil or item.leaseID == cjson.null) and math.floor(item.at / 1000) < at then
-			at = math.floor(item.at / 1000)
+	-- the partition.  This uses each item's score rather than its time, as
+	-- items over their concurrency limit are scored when capacity may be
+	-- available.
+	local ids = {}
+	for i = 1, #items, 2 do
+		table.insert(ids, items[i])
+	end
+	local encoded = redis.call("HMGET", queueKey, unpack(ids))
+	for k, v in ipairs(encoded) do
+		local ok, item = pcall(cjson.decode, v)
+		local score = math.floor(tonumber(items[k * 2]) / 1000)
+		if ok and (item.leaseID == nil or item.leaseID == cjson.null) and score < at then
+			at = score
 			break
 		end
 	end
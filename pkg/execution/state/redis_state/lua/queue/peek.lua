@@ -1,6 +1,6 @@
 --[[
 
-Peek returns items from the queue in order.
+Peek returns items from the queue in order, followed by the ID of each item.
 
 ]]
 
@@ -15,4 +15,9 @@ if #items == 0 then
 	return {}
 end
 
-return redis.call("HMGET", queueKey, unpack(items))
+local result = redis.call("HMGET", queueKey, unpack(items))
+-- Append each item's ID, allowing items which can't be read to be dead-lettered.
+for _, id in ipairs(items) do
+	table.insert(result, id)
+end
+return result
@@ -5,6 +5,7 @@ Re-enqueus a queue item within its queue, removing any lease.
 Output:
   0: Successfully re-enqueued item
   1: Queue item not found
+  2: Queue item is leased
 
 ]]
 
@@ -12,24 +13,35 @@ local queueKey          = KEYS[1] -- queue:item - hash: { $itemID: $item }
 local queueIndexKey     = KEYS[2] -- queue:sorted:$workflowID - zset
 local partitionKey      = KEYS[3] -- partition:item:$workflowID - hash { n: $leased, len: $enqueued }
 local partitionIndexKey = KEYS[4] -- partition:sorted - zset
+local concurrencyKey    = KEYS[5] -- concurrency:$key - zset
 
 local queueItem      = ARGV[1] -- {id, lease id, attempt, max attempt, data, etc...}
 local queueID        = ARGV[2] -- id
 local queueScore     = tonumber(ARGV[3]) -- vesting time, in ms
 local partitionIndex = ARGV[4] -- workflowID
 local partitionItem  = ARGV[5] -- {workflow, priority, leasedAt, etc}
+local unleasedAt     = tonumber(ARGV[6]) -- in ms;  if > 0, leased items aren't requeued
 
 -- $include(get_queue_item.lua)
+-- $include(decode_ulid_time.lua)
 local item = get_queue_item(queueKey, queueID)
 if item == nil then
 	return 1
 end
 
+if unleasedAt > 0 and item.leaseID ~= nil and item.leaseID ~= cjson.null and decode_ulid_time(item.leaseID) > unleasedAt then
+	-- The item is being worked on;  leave it as-is.
+	return 2
+end
+
 if item.leaseID ~= nil and item.leaseID ~= cjson.null then
 	-- Remove total number in progress if there's a lease.
 	redis.call("HINCRBY", partitionKey, "n", -1)
 end
 
+-- Free the item's capacity within its concurrency limit, if any.
+redis.call("ZREM", concurrencyKey, queueID)
+
 redis.call("HSET", queueKey, queueID, queueItem) 
 -- Update the queue score
 redis.call("ZADD", queueIndexKey, queueScore, queueID)
@@ -0,0 +1,42 @@
+--[[
+
+Reserves a slot for a queue item within a throttle key, returning the earliest
+time in milliseconds that the item can start.  At most `limit` items may start
+within any `period`;  items over the limit are pushed back instead of dropped.
+
+This is idempotent:  reserving a slot for the same item returns the previously
+reserved time.
+
+--]]
+
+local throttleKey = KEYS[1]           -- throttle:$key - zset: { $itemID: $startMS }
+
+local itemID      = ARGV[1]
+local atMS        = tonumber(ARGV[2]) -- requested start time, in milliseconds
+local limit       = tonumber(ARGV[3])
+local periodMS    = tonumber(ARGV[4])
+
+local existing = redis.call("ZSCORE", throttleKey, itemID)
+if existing ~= false then
+	return tonumber(existing)
+end
+
+-- Remove any reservations which no longer fall within the current window.
+redis.call("ZREMRANGEBYSCORE", throttleKey, "-inf", atMS - periodMS)
+
+local count = redis.call("ZCARD", throttleKey)
+local startMS = atMS
+if count >= limit then
+	-- The item must start at least one period after the limit-th most recent
+	-- reservation, ensuring no window contains more than `limit` items.
+	local prior = redis.call("ZRANGE", throttleKey, count - limit, count - limit, "WITHSCORES")
+	local allowed = tonumber(prior[2]) + periodMS
+	if allowed > startMS then
+		startMS = allowed
+	end
+end
+
+redis.call("ZADD", throttleKey, startMS, itemID)
+redis.call("PEXPIRE", throttleKey, (startMS - atMS) + periodMS)
+
+return startMS
@@ -15,6 +15,7 @@ local actionKey   = KEYS[1]
 local errorKey    = KEYS[2]
 local metadataKey = KEYS[3]
 local historyKey  = KEYS[4]
+local fnRunsKey   = KEYS[5]
 
 local data    = ARGV[1]
 local stepID  = ARGV[2]
@@ -23,6 +24,7 @@ local isFinal = tonumber(ARGV[4])
 local stepLog = ARGV[5] -- The step log.
 local failLog = ARGV[6] -- An optional fail log, if the error is final
 local logTime = tonumber(ARGV[7]) -- The timestamp for the log, unix milliseconds
+local runID   = ARGV[8]
 
 if isError == 0 then
 	-- Save the step output under step data.
@@ -41,5 +43,6 @@ end
 redis.call("HINCRBY", metadataKey, "pending", -1) 
 redis.call("HSET", metadataKey, "status", 2)  -- Mark as failed
 redis.call("ZADD", historyKey, logTime+1, failLog) -- The function failed log
+redis.call("ZREM", fnRunsKey, runID)
 
 return 0
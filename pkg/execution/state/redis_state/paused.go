@@ -0,0 +1,56 @@
+package redis_state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PartitionPausedExtension is the length of time that a paused function's partition
+// is pushed back when attempting to lease the partition.  Resuming a function
+// requeues its partition immediately.
+const PartitionPausedExtension = 30 * time.Second
+
+// PauseFunction stops leasing the given function's partition until the function is
+// resumed.  Items can still be enqueued for the function.
+func (q *queue) PauseFunction(ctx context.Context, workflowID uuid.UUID) error {
+	if err := q.r.SAdd(ctx, q.kg.PausedFunctions(), workflowID.String()).Err(); err != nil {
+		return fmt.Errorf("error pausing function: %w", err)
+	}
+	return nil
+}
+
+// ResumeFunction resumes leasing the given function's partition, requeueing the
+// partition so that its earliest items are processed immediately.
+func (q *queue) ResumeFunction(ctx context.Context, workflowID uuid.UUID) error {
+	if err := q.r.SRem(ctx, q.kg.PausedFunctions(), workflowID.String()).Err(); err != nil {
+		return fmt.Errorf("error resuming function: %w", err)
+	}
+
+	err := q.PartitionRequeue(ctx, workflowID, time.Now())
+	if err == ErrPartitionNotFound || err == ErrPartitionGarbageCollected {
+		// There's nothing enqueued for this function.
+		return nil
+	}
+	return err
+}
+
+// PausedFunctions returns the IDs of all paused functions.
+func (q *queue) PausedFunctions(ctx context.Context) ([]uuid.UUID, error) {
+	members, err := q.r.SMembers(ctx, q.kg.PausedFunctions()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error loading paused functions: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, m := range members {
+		id, err := uuid.Parse(m)
+		if err != nil {
+			return nil, fmt.Errorf("error reading paused function: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,136 @@
+package redis_state
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	osqueue "github.com/inngest/inngest/pkg/execution/queue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueuePauseFunction(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 100})
+	defer rc.Close()
+	q := NewQueue(rc)
+	ctx := context.Background()
+
+	var _ osqueue.FunctionPauser = q
+
+	idA, idB := uuid.New(), uuid.New()
+	now := time.Now().Truncate(time.Second)
+
+	_, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: idA}, now)
+	require.NoError(t, err)
+	_, err = q.EnqueueItem(ctx, QueueItem{WorkflowID: idB}, now)
+	require.NoError(t, err)
+
+	err = q.PauseFunction(ctx, idA)
+	require.NoError(t, err)
+
+	t.Run("It lists paused functions", func(t *testing.T) {
+		ids, err := q.PausedFunctions(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{idA}, ids)
+	})
+
+	t.Run("It doesn't lease paused partitions", func(t *testing.T) {
+		_, err := q.PartitionLease(ctx, idA, time.Second)
+		require.Equal(t, ErrPartitionPaused, err)
+
+		// The partition is pushed back so that it isn't continually peeked.
+		score, err := r.ZScore(defaultQueueKey.PartitionIndex(), idA.String())
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, int64(score), time.Now().Add(PartitionPausedExtension).Unix()-1)
+
+		// Other functions are unaffected.
+		_, err = q.PartitionLease(ctx, idB, time.Second)
+		require.NoError(t, err)
+	})
+
+	t.Run("It enqueues items for paused functions", func(t *testing.T) {
+		_, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: idA}, now.Add(time.Second))
+		require.NoError(t, err)
+
+		items, err := q.Peek(ctx, idA, time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+	})
+
+	t.Run("It leases partitions once resumed", func(t *testing.T) {
+		err := q.ResumeFunction(ctx, idA)
+		require.NoError(t, err)
+
+		ids, err := q.PausedFunctions(ctx)
+		require.NoError(t, err)
+		require.Empty(t, ids)
+
+		// The partition is requeued for its earliest item.
+		score, err := r.ZScore(defaultQueueKey.PartitionIndex(), idA.String())
+		require.NoError(t, err)
+		require.EqualValues(t, now.Unix(), int64(score))
+
+		_, err = q.PartitionLease(ctx, idA, time.Second)
+		require.NoError(t, err)
+	})
+
+	t.Run("It resumes functions without enqueued items", func(t *testing.T) {
+		id := uuid.New()
+		require.NoError(t, q.PauseFunction(ctx, id))
+		require.NoError(t, q.ResumeFunction(ctx, id))
+	})
+}
+
+func TestQueueRunPausedFunction(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 50})
+	defer rc.Close()
+	q := NewQueue(rc, WithNumWorkers(10))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	id := uuid.New()
+	require.NoError(t, q.PauseFunction(ctx, id))
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := q.EnqueueItem(ctx, QueueItem{
+			WorkflowID: id,
+			Data:       osqueue.Item{Kind: osqueue.KindEdge, Attempt: i},
+		}, start.Add(time.Duration(i)*time.Millisecond))
+		require.NoError(t, err)
+	}
+
+	mu := &sync.Mutex{}
+	handled := []int{}
+	go func() {
+		_ = q.Run(ctx, func(ctx context.Context, item osqueue.Item) error {
+			mu.Lock()
+			defer mu.Unlock()
+			handled = append(handled, item.Attempt)
+			return nil
+		})
+	}()
+
+	<-time.After(time.Second)
+	mu.Lock()
+	require.Empty(t, handled)
+	mu.Unlock()
+
+	require.NoError(t, q.ResumeFunction(ctx, id))
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(handled) == 3
+	}, 5*time.Second, 50*time.Millisecond)
+
+	mu.Lock()
+	require.Equal(t, []int{0, 1, 2}, handled)
+	mu.Unlock()
+}
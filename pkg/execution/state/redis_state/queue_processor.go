@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/emperorearth/vitess/go/ewma"
-	"github.com/inngest/inngest/pkg/backoff"
 	osqueue "github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/logger"
 	"github.com/oklog/ulid/v2"
@@ -198,7 +197,12 @@ func (q *queue) processPartition(ctx context.Context, p *QueuePartition, f osque
 	// Attempt to lease items
 	_, err := q.PartitionLease(ctx, p.WorkflowID, PartitionLeaseDuration)
 	if err == ErrPartitionAlreadyLeased {
-		// TODO: Increase metric for partition contention
+		q.metrics.Counter("partition_lease_contention").Inc(1)
+		return nil
+	}
+	if err == ErrPartitionPaused {
+		// The function is paused;  its items stay in the queue until resumed.
+		q.metrics.Counter("partition_paused").Inc(1)
 		return nil
 	}
 	if err != nil {
@@ -218,14 +222,22 @@ func (q *queue) processPartition(ctx context.Context, p *QueuePartition, f osque
 	// order, depending on how long it takes for the item to pass through the channel
 	// to the worker, how long Redis takes to lease the item, etc.
 	fetch := time.Now().Truncate(time.Second).Add(2 * time.Second)
-	queue, err := q.Peek(peekCtx, p.WorkflowID, fetch, q.peekSize())
+	queue, unreadable, err := q.peek(peekCtx, p.WorkflowID, fetch, q.peekSize())
 	if err != nil {
 		return err
 	}
 
+	for _, u := range unreadable {
+		// This item can never be processed;  move it to the dead-letter queue
+		// so that it doesn't block the partition.
+		if err := q.deadLetterRaw(ctx, p.WorkflowID, u.ID, u.Raw, u.Err); err != nil && err != ErrQueueItemNotFound {
+			return fmt.Errorf("error dead-lettering unreadable queue item: %w", err)
+		}
+	}
+
 	for _, item := range queue {
 		if item.LeaseID != nil && ulid.Time(item.LeaseID.Time()).After(time.Now()) {
-			// TODO: Increase metric for queue contention
+			q.metrics.Counter("item_lease_contention").Inc(1)
 			continue
 		}
 
@@ -242,14 +254,21 @@ func (q *queue) processPartition(ctx context.Context, p *QueuePartition, f osque
 		//
 		// This is safe:  only one process runs scan(), and we guard the total number of
 		// available workers with the above semaphore.
-		leaseID, err := q.Lease(ctx, item.WorkflowID, item.ID, QueueLeaseDuration)
+		leaseID, err := q.Lease(ctx, *item, QueueLeaseDuration)
 		if err == ErrQueueItemNotFound {
 			// Already handled.
 			q.sem.Release(1)
 			continue
 		}
+		if err == ErrQueueItemConcurrencyLimit {
+			// The function or key is at capacity;  the item is left in the
+			// queue, and isn't peeked until capacity may be available.
+			q.metrics.Counter("items_concurrency_limited").Inc(1)
+			q.sem.Release(1)
+			continue
+		}
 		if err == ErrQueueItemAlreadyLeased {
-			// XXX: Increase counter for lease contention
+			q.metrics.Counter("item_lease_contention").Inc(1)
 			q.sem.Release(1)
 			logger.From(ctx).Warn().Interface("item", item).Msg("worker attempting to claim existing lease")
 			continue
@@ -292,10 +311,15 @@ func (q *queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) er
 	extendLeaseTick := time.NewTicker(QueueLeaseDuration / 2)
 	defer extendLeaseTick.Stop()
 
-	// XXX: Increase counter for queue items processed
-	// XXX: Increase / defer decrease gauge for items processing
+	q.metrics.Counter("items_processed").Inc(1)
+	q.metrics.Gauge("items_in_flight").Update(float64(atomic.AddInt64(&q.inFlight, 1)))
+	defer func() {
+		q.metrics.Gauge("items_in_flight").Update(float64(atomic.AddInt64(&q.inFlight, -1)))
+	}()
 
-	errCh := make(chan error)
+	// errCh is buffered so that the job, lease extension, and max duration timer
+	// never block on sending once the first error has been handled.
+	errCh := make(chan error, 3)
 	doneCh := make(chan struct{})
 
 	// Continually extend lease in the background while we're working on this job
@@ -311,7 +335,7 @@ func (q *queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) er
 				}
 				leaseID, err = q.ExtendLease(ctx, qi, *leaseID, QueueLeaseDuration)
 				if err != nil && err != ErrQueueItemNotFound {
-					// XXX: Increase counter here.
+					q.metrics.Counter("lease_extension_errors").Inc(1)
 					logger.From(ctx).Error().Err(err).Msg("error extending lease")
 					errCh <- fmt.Errorf("error extending lease while processing: %w", err)
 					return
@@ -320,7 +344,6 @@ func (q *queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) er
 		}
 	}()
 
-	// XXX: Add a max job time here, configurable.
 	jobCtx, jobCancel := context.WithCancel(ctx)
 	defer jobCancel()
 
@@ -344,13 +367,28 @@ func (q *queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) er
 			defer latencySem.Unlock()
 			latency := time.Since(time.UnixMilli(qi.AtMS))
 			latencyAvg.AddValue(float64(latency))
-			// XXX: Add indinvidual latency to metrics
+			q.metrics.Timer("scheduling_latency").Record(latency)
 		}()
 
-		err := f(jobCtx, qi.Data)
+		runCtx := jobCtx
+		if dur := q.maxDuration(qi); dur > 0 {
+			// Cancel the job once it exceeds its max duration, and retry it
+			// without waiting for the job to return.
+			var runCancel context.CancelFunc
+			runCtx, runCancel = context.WithTimeout(jobCtx, dur)
+			defer runCancel()
+			go func() {
+				<-runCtx.Done()
+				if runCtx.Err() == context.DeadlineExceeded {
+					errCh <- osqueue.JobTimeoutError{MaxDuration: dur}
+				}
+			}()
+		}
+
+		err := f(runCtx, qi.Data)
 		extendLeaseTick.Stop()
 		if err != nil {
-			// XXX: Increase counter for queue item error
+			q.metrics.Counter("items_errored").Inc(1)
 			errCh <- err
 			return
 		}
@@ -364,10 +402,25 @@ func (q *queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) er
 		// Job errored or extending lease errored.  Cancel the job ASAP.
 		jobCancel()
 
+		if _, ok := err.(osqueue.JobTimeoutError); ok {
+			// The job may have completed as it timed out.  Give the job a
+			// moment to return, using its result if it does so that completed
+			// jobs aren't retried.
+			select {
+			case err = <-errCh:
+			case <-doneCh:
+				if err := q.Dequeue(ctx, qi); err != nil {
+					return err
+				}
+				return nil
+			case <-time.After(jobTimeoutGrace):
+			}
+		}
+
 		if osqueue.ShouldRetry(err, qi.Data.Attempt, qi.Data.GetMaxAttempts()) {
-			// XXX: Increase errored count
+			q.metrics.Counter("items_requeued").Inc(1)
 			qi.Data.Attempt += 1
-			at := backoff.LinearJitterBackoff(qi.Data.Attempt)
+			at := qi.Data.RetryAt()
 			logger.From(ctx).Info().Err(err).Int64("at_ms", at.UnixMilli()).Interface("item", qi).Msg("requeuing job")
 			if err := q.Requeue(ctx, qi, at); err != nil {
 				logger.From(ctx).Error().Err(err).Interface("item", qi).Msg("error requeuing job")
@@ -376,10 +429,10 @@ func (q *queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) er
 			return nil
 		}
 
-		// Dequeue this entirely, as this permanently failed.
-		// XXX: Increase permanently failed counter here.
-		logger.From(ctx).Info().Interface("item", qi).Msg("dequeueing failed job")
-		if err := q.Dequeue(ctx, qi); err != nil {
+		// Move this to the dead-letter queue, as this permanently failed.
+		q.metrics.Counter("items_dead_lettered").Inc(1)
+		logger.From(ctx).Info().Err(err).Interface("item", qi).Msg("dead-lettering failed job")
+		if err := q.deadLetter(ctx, qi, err); err != nil {
 			return err
 		}
 
@@ -397,6 +450,15 @@ func (q *queue) process(ctx context.Context, qi QueueItem, f osqueue.RunFunc) er
 	return nil
 }
 
+// maxDuration returns the maximum time that the given item can run for, or zero
+// if the item can run indefinitely.
+func (q *queue) maxDuration(qi QueueItem) time.Duration {
+	if qi.Data.MaxDuration > 0 {
+		return qi.Data.MaxDuration
+	}
+	return q.maxJobDuration
+}
+
 // sequentialLease is a helper method for concurrently reading the sequential
 // lease ID.
 func (q *queue) sequentialLease() *ulid.ULID {
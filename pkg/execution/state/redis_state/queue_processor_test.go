@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	mrand "math/rand"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -12,11 +13,13 @@ import (
 	"github.com/alicebob/miniredis/v2"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
+	"github.com/inngest/inngest/pkg/backoff"
 	osqueue "github.com/inngest/inngest/pkg/execution/queue"
 	"github.com/inngest/inngest/pkg/execution/state"
 	"github.com/inngest/inngest/pkg/logger"
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally/v4"
 )
 
 func TestQueueRunSequential(t *testing.T) {
@@ -85,10 +88,12 @@ func max(i int) *int {
 func TestQueueRunBasic(t *testing.T) {
 	r := miniredis.RunT(t)
 	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 50})
+	scope := tally.NewTestScope("queue", map[string]string{})
 	q := NewQueue(
 		rc,
 		// We can't add more than 8128 goroutines when detecting race conditions.
 		WithNumWorkers(10),
+		WithMetricsScope(scope),
 	)
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -143,7 +148,267 @@ func TestQueueRunBasic(t *testing.T) {
 
 	// TODO: Assert queue items have been processed
 	// TODO: Assert queue items have been dequeued, and peek is nil for workflows.
-	// XXX: Assert metrics are correct.
+
+	counters := scope.Snapshot().Counters()
+	require.EqualValues(t, len(items), counters["queue.items_enqueued+"].Value())
+	require.EqualValues(t, len(items), counters["queue.items_processed+"].Value())
+	require.Nil(t, counters["queue.items_errored+"])
+	require.EqualValues(t, 0, scope.Snapshot().Gauges()["queue.items_in_flight+"].Value())
+	require.Len(t, scope.Snapshot().Timers()["queue.scheduling_latency+"].Values(), len(items))
+}
+
+func TestQueueRunConcurrency(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 50})
+	defer rc.Close()
+	q := NewQueue(
+		rc,
+		WithNumWorkers(10),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		handled int32
+		running int32
+		maxSeen int32
+	)
+	go func() {
+		_ = q.Run(ctx, func(ctx context.Context, item osqueue.Item) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				seen := atomic.LoadInt32(&maxSeen)
+				if n <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, n) {
+					break
+				}
+			}
+			<-time.After(50 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			atomic.AddInt32(&handled, 1)
+			return nil
+		})
+	}()
+
+	id := uuid.New()
+	for i := 0; i < 6; i++ {
+		_, err := q.EnqueueItem(ctx, QueueItem{
+			WorkflowID: id,
+			Data: osqueue.Item{
+				Kind: osqueue.KindEdge,
+				Identifier: state.Identifier{
+					WorkflowID:  id,
+					RunID:       ulid.MustNew(ulid.Now(), rand.Reader),
+					Concurrency: &state.Concurrency{Key: id.String(), Limit: 2},
+				},
+			},
+		}, time.Now())
+		require.NoError(t, err)
+	}
+
+	<-time.After(3 * time.Second)
+	require.EqualValues(t, 6, atomic.LoadInt32(&handled))
+	require.EqualValues(t, 2, atomic.LoadInt32(&maxSeen), "must never run more than the concurrency limit")
+}
+
+func TestQueueRunConcurrencyLimited(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 50})
+	defer rc.Close()
+	scope := tally.NewTestScope("", nil)
+	q := NewQueue(
+		rc,
+		WithNumWorkers(10),
+		WithMetricsScope(scope),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var handled sync.Map
+	go func() {
+		_ = q.Run(ctx, func(ctx context.Context, item osqueue.Item) error {
+			if item.Identifier.Concurrency != nil {
+				<-time.After(3 * time.Second)
+			}
+			handled.Store(item.Identifier.RunID, true)
+			return nil
+		})
+	}()
+
+	id := uuid.New()
+	enqueue := func(c *state.Concurrency, at time.Time) ulid.ULID {
+		runID := ulid.MustNew(ulid.Now(), rand.Reader)
+		_, err := q.EnqueueItem(ctx, QueueItem{
+			WorkflowID: id,
+			Data: osqueue.Item{
+				Kind: osqueue.KindEdge,
+				Identifier: state.Identifier{
+					WorkflowID:  id,
+					RunID:       runID,
+					Concurrency: c,
+				},
+			},
+		}, at)
+		require.NoError(t, err)
+		return runID
+	}
+
+	// Items over the limit fill each peek until they're requeued, and must not
+	// block the unlimited item behind them.
+	start := time.Now()
+	for i := 0; i < 11; i++ {
+		enqueue(&state.Concurrency{Key: id.String(), Limit: 1}, start.Add(time.Duration(i)*time.Millisecond))
+	}
+	unlimited := enqueue(nil, start.Add(20*time.Millisecond))
+
+	require.Eventually(t, func() bool {
+		_, ok := handled.Load(unlimited)
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	// Limited items are requeued until capacity may be available, rather than
+	// leased on every scan.
+	<-time.After(time.Until(start.Add(1500 * time.Millisecond)))
+	limited := scope.Snapshot().Counters()["items_concurrency_limited+"]
+	require.NotNil(t, limited)
+	require.Less(t, limited.Value(), int64(100))
+}
+
+func TestQueueRunBackoff(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 50})
+	defer rc.Close()
+	q := NewQueue(
+		rc,
+		WithNumWorkers(10),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var attempts int32
+	go func() {
+		_ = q.Run(ctx, func(ctx context.Context, item osqueue.Item) error {
+			atomic.AddInt32(&attempts, 1)
+			return fmt.Errorf("failed")
+		})
+	}()
+
+	id := uuid.New()
+	_, err := q.EnqueueItem(ctx, QueueItem{
+		WorkflowID: id,
+		Data: osqueue.Item{
+			Kind:        osqueue.KindEdge,
+			MaxAttempts: max(3),
+			Identifier: state.Identifier{
+				WorkflowID: id,
+				RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+			},
+			// Without the item's backoff, the first retry runs after at
+			// least 10 seconds.
+			Backoff: &backoff.Policy{Kind: backoff.KindFixed, Base: 200 * time.Millisecond},
+		},
+	}, time.Now())
+	require.NoError(t, err)
+
+	<-time.After(2 * time.Second)
+	require.EqualValues(t, 3, atomic.LoadInt32(&attempts), "retries must use the item's backoff")
+
+	dls, err := q.DeadLetters(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dls), "permanently failed items must be dead-lettered")
+	require.Equal(t, "failed", dls[0].Error)
+	require.Equal(t, 2, dls[0].Attempt)
+}
+
+func TestQueueRunMaxDuration(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 50})
+	defer rc.Close()
+	q := NewQueue(
+		rc,
+		WithNumWorkers(10),
+		WithMaxJobDuration(200*time.Millisecond),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hung, cancelled, completed := uuid.New(), uuid.New(), uuid.New()
+	block := make(chan struct{})
+	defer close(block)
+
+	var (
+		hungAttempts      int32
+		completedAttempts int32
+		elapsed           int64
+	)
+	go func() {
+		_ = q.Run(ctx, func(ctx context.Context, item osqueue.Item) error {
+			switch item.Identifier.WorkflowID {
+			case hung:
+				if atomic.AddInt32(&hungAttempts, 1) == 1 {
+					// Ignore the context entirely, never returning.
+					<-block
+				}
+			case cancelled:
+				start := time.Now()
+				<-ctx.Done()
+				if ctx.Err() == context.DeadlineExceeded {
+					atomic.StoreInt64(&elapsed, int64(time.Since(start)))
+				}
+				return ctx.Err()
+			case completed:
+				// Complete successfully as the job times out.
+				atomic.AddInt32(&completedAttempts, 1)
+				<-ctx.Done()
+				return nil
+			}
+			return nil
+		})
+	}()
+
+	item := func(id uuid.UUID, max time.Duration) QueueItem {
+		return QueueItem{
+			WorkflowID: id,
+			Data: osqueue.Item{
+				Kind: osqueue.KindEdge,
+				Identifier: state.Identifier{
+					WorkflowID: id,
+					RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+				},
+				MaxDuration: max,
+				Backoff:     &backoff.Policy{Kind: backoff.KindFixed, Base: 10 * time.Millisecond},
+			},
+		}
+	}
+
+	_, err := q.EnqueueItem(ctx, item(hung, 0), time.Now())
+	require.NoError(t, err)
+	_, err = q.EnqueueItem(ctx, item(cancelled, 50*time.Millisecond), time.Now())
+	require.NoError(t, err)
+	_, err = q.EnqueueItem(ctx, item(completed, 50*time.Millisecond), time.Now())
+	require.NoError(t, err)
+
+	t.Run("It retries jobs which exceed the queue's max duration", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&hungAttempts) == 2
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("It cancels the job's context after the item's max duration", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt64(&elapsed) > 0
+		}, time.Second, 10*time.Millisecond)
+		require.Less(t, time.Duration(atomic.LoadInt64(&elapsed)), 200*time.Millisecond)
+	})
+
+	t.Run("It doesn't retry jobs which complete as they time out", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			items, err := q.Peek(ctx, completed, time.Now().Add(time.Hour), 10)
+			require.NoError(t, err)
+			return len(items) == 0
+		}, time.Second, 10*time.Millisecond)
+		<-time.After(100 * time.Millisecond)
+		require.EqualValues(t, 1, atomic.LoadInt32(&completedAttempts))
+	})
 }
 
 // TestQueueRunExtended runs an extended in-memory test which:
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -168,6 +169,88 @@ func TestQueueEnqueueItemIdempotency(t *testing.T) {
 	})
 }
 
+func TestQueueEnqueueItemThrottle(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 100})
+	defer rc.Close()
+	q := NewQueue(rc)
+	ctx := context.Background()
+
+	start := time.Now().Truncate(time.Second)
+	period := 10 * time.Second
+
+	throttled := func(key string) QueueItem {
+		return QueueItem{
+			Data: osqueue.Item{
+				Throttle: &osqueue.Throttle{
+					Key:    key,
+					Limit:  2,
+					Period: int(period.Seconds()),
+				},
+			},
+		}
+	}
+
+	t.Run("It delays bursts over the limit", func(t *testing.T) {
+		expected := []time.Time{
+			start,
+			start,
+			start.Add(period),
+			start.Add(period),
+			start.Add(2 * period),
+		}
+		for n, at := range expected {
+			item, err := q.EnqueueItem(ctx, throttled("burst"), start)
+			require.NoError(t, err)
+			require.Equal(t, at.UnixMilli(), item.AtMS, "item %d", n)
+			requireItemScoreEquals(t, r, item, at)
+		}
+	})
+
+	t.Run("It throttles each key independently", func(t *testing.T) {
+		for _, key := range []string{"a", "b"} {
+			for i := 0; i < 2; i++ {
+				item, err := q.EnqueueItem(ctx, throttled(key), start)
+				require.NoError(t, err)
+				require.Equal(t, start.UnixMilli(), item.AtMS, "key %s", key)
+			}
+		}
+
+		item, err := q.EnqueueItem(ctx, throttled("a"), start)
+		require.NoError(t, err)
+		require.Equal(t, start.Add(period).UnixMilli(), item.AtMS)
+	})
+
+	t.Run("It allows items once the period has passed", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := q.EnqueueItem(ctx, throttled("window"), start)
+			require.NoError(t, err)
+		}
+
+		later := start.Add(period)
+		item, err := q.EnqueueItem(ctx, throttled("window"), later)
+		require.NoError(t, err)
+		require.Equal(t, later.UnixMilli(), item.AtMS)
+	})
+
+	t.Run("Reserving the same item twice is idempotent", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := q.EnqueueItem(ctx, throttled("idempotent"), start)
+			require.NoError(t, err)
+		}
+
+		item := throttled("idempotent")
+		item.ID = "duplicate"
+		first, err := q.EnqueueItem(ctx, item, start)
+		require.NoError(t, err)
+		require.Equal(t, start.Add(period).UnixMilli(), first.AtMS)
+
+		second, err := q.EnqueueItem(ctx, item, start)
+		require.Equal(t, ErrQueueItemExists, err)
+		require.Equal(t, first.AtMS, second.AtMS)
+	})
+}
+
 func TestQueuePeek(t *testing.T) {
 	r := miniredis.RunT(t)
 	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 100})
@@ -228,7 +311,7 @@ func TestQueuePeek(t *testing.T) {
 
 		t.Run("It should remove any leased items from the list", func(t *testing.T) {
 			// Lease step B, and it should be removed.
-			leaseID, err := q.Lease(ctx, ia.WorkflowID, ia.ID, 50*time.Millisecond)
+			leaseID, err := q.Lease(ctx, ia, 50*time.Millisecond)
 			require.NoError(t, err)
 
 			items, err = q.Peek(ctx, uuid.UUID{}, d, QueuePeekMax)
@@ -266,7 +349,7 @@ func TestQueueLease(t *testing.T) {
 		require.Nil(t, item.LeaseID)
 
 		now := time.Now()
-		id, err := q.Lease(ctx, item.WorkflowID, item.ID, time.Second)
+		id, err := q.Lease(ctx, item, time.Second)
 		require.NoError(t, err)
 
 		item = getQueueItem(t, r, item.ID)
@@ -282,7 +365,7 @@ func TestQueueLease(t *testing.T) {
 
 		t.Run("Leasing again should fail", func(t *testing.T) {
 			for i := 0; i < 50; i++ {
-				id, err := q.Lease(ctx, item.WorkflowID, item.ID, time.Second)
+				id, err := q.Lease(ctx, item, time.Second)
 				require.Equal(t, ErrQueueItemAlreadyLeased, err)
 				require.Nil(t, id)
 				<-time.After(5 * time.Millisecond)
@@ -292,7 +375,7 @@ func TestQueueLease(t *testing.T) {
 		t.Run("Leasing an expired lease should succeed", func(t *testing.T) {
 			<-time.After(1005 * time.Millisecond)
 			now := time.Now()
-			id, err := q.Lease(ctx, item.WorkflowID, item.ID, 5*time.Second)
+			id, err := q.Lease(ctx, item, 5*time.Second)
 			require.NoError(t, err)
 			require.NoError(t, err)
 
@@ -316,7 +399,7 @@ func TestQueueLease(t *testing.T) {
 
 			requireItemScoreEquals(t, r, item, start)
 
-			_, err = q.Lease(ctx, item.WorkflowID, item.ID, time.Minute)
+			_, err = q.Lease(ctx, item, time.Minute)
 			require.NoError(t, err)
 
 			requireItemScoreEquals(t, r, item, start.Add(time.Minute))
@@ -324,6 +407,129 @@ func TestQueueLease(t *testing.T) {
 	})
 }
 
+func TestQueueLeaseConcurrency(t *testing.T) {
+	r := miniredis.RunT(t)
+	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 100})
+	defer rc.Close()
+	q := NewQueue(rc)
+	ctx := context.Background()
+
+	start := time.Now().Truncate(time.Second)
+	wfID := uuid.New()
+
+	enqueue := func(t *testing.T, key string, limit int) QueueItem {
+		item, err := q.EnqueueItem(ctx, QueueItem{
+			WorkflowID: wfID,
+			Data: osqueue.Item{
+				Identifier: state.Identifier{
+					WorkflowID:  wfID,
+					Concurrency: &state.Concurrency{Key: key, Limit: limit},
+				},
+			},
+		}, start)
+		require.NoError(t, err)
+		return item
+	}
+
+	t.Run("It leaves items over the limit in the queue", func(t *testing.T) {
+		a := enqueue(t, "fn", 2)
+		b := enqueue(t, "fn", 2)
+		c := enqueue(t, "fn", 2)
+
+		_, err := q.Lease(ctx, a, time.Second)
+		require.NoError(t, err)
+		_, err = q.Lease(ctx, b, time.Second)
+		require.NoError(t, err)
+
+		id, err := q.Lease(ctx, c, time.Second)
+		require.Equal(t, ErrQueueItemConcurrencyLimit, err)
+		require.Nil(t, id)
+
+		// The item is unleased and still available.
+		found := getQueueItem(t, r, c.ID)
+		require.Nil(t, found.LeaseID)
+
+		// The item isn't peeked until capacity may be available.
+		items, err := q.Peek(ctx, wfID, time.Now(), 10)
+		require.NoError(t, err)
+		require.Empty(t, items)
+		score, err := r.ZScore(q.kg.QueueIndex(wfID.String()), c.ID)
+		require.NoError(t, err)
+		require.LessOrEqual(t, int64(score), time.Now().Add(time.Second).UnixMilli())
+
+		t.Run("Dequeueing an item frees capacity", func(t *testing.T) {
+			err := q.Dequeue(ctx, a)
+			require.NoError(t, err)
+
+			_, err = q.Lease(ctx, c, time.Second)
+			require.NoError(t, err)
+		})
+
+		t.Run("Requeueing an item frees capacity", func(t *testing.T) {
+			d := enqueue(t, "fn", 2)
+			_, err := q.Lease(ctx, d, time.Second)
+			require.Equal(t, ErrQueueItemConcurrencyLimit, err)
+
+			err = q.Requeue(ctx, b, start)
+			require.NoError(t, err)
+
+			_, err = q.Lease(ctx, d, time.Second)
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("It limits each key independently", func(t *testing.T) {
+		a := enqueue(t, "key-a", 1)
+		b := enqueue(t, "key-b", 1)
+		a2 := enqueue(t, "key-a", 1)
+
+		_, err := q.Lease(ctx, a, time.Second)
+		require.NoError(t, err)
+		_, err = q.Lease(ctx, b, time.Second)
+		require.NoError(t, err)
+		_, err = q.Lease(ctx, a2, time.Second)
+		require.Equal(t, ErrQueueItemConcurrencyLimit, err)
+	})
+
+	t.Run("Expired leases do not count towards the limit", func(t *testing.T) {
+		a := enqueue(t, "expiring", 1)
+		b := enqueue(t, "expiring", 1)
+
+		_, err := q.Lease(ctx, a, 50*time.Millisecond)
+		require.NoError(t, err)
+		_, err = q.Lease(ctx, b, time.Second)
+		require.Equal(t, ErrQueueItemConcurrencyLimit, err)
+
+		<-time.After(60 * time.Millisecond)
+		_, err = q.Lease(ctx, b, time.Second)
+		require.NoError(t, err)
+	})
+
+	t.Run("Extending a lease keeps capacity", func(t *testing.T) {
+		a := enqueue(t, "extend", 1)
+		b := enqueue(t, "extend", 1)
+
+		leaseID, err := q.Lease(ctx, a, 50*time.Millisecond)
+		require.NoError(t, err)
+		a = getQueueItem(t, r, a.ID)
+		_, err = q.ExtendLease(ctx, a, *leaseID, time.Second)
+		require.NoError(t, err)
+
+		<-time.After(60 * time.Millisecond)
+		_, err = q.Lease(ctx, b, time.Second)
+		require.Equal(t, ErrQueueItemConcurrencyLimit, err)
+	})
+
+	t.Run("Items without limits are unaffected", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			item, err := q.EnqueueItem(ctx, QueueItem{WorkflowID: wfID}, start)
+			require.NoError(t, err)
+			_, err = q.Lease(ctx, item, time.Second)
+			require.NoError(t, err)
+		}
+	})
+}
+
 func TestQueueExtendLease(t *testing.T) {
 	r := miniredis.RunT(t)
 	rc := redis.NewClient(&redis.Options{Addr: r.Addr(), PoolSize: 100})
@@ -340,7 +546,7 @@ func TestQueueExtendLease(t *testing.T) {
 		require.Nil(t, item.LeaseID)
 
 		now := time.Now()
-		id, err := q.Lease(ctx, item.WorkflowID, item.ID, time.Second)
+		id, err := q.Lease(ctx, item, time.Second)
 		require.NoError(t, err)
 
 		item = getQueueItem(t, r, item.ID)
@@ -357,6 +563,8 @@ func TestQueueExtendLease(t *testing.T) {
 		require.NotNil(t, item.LeaseID)
 		require.EqualValues(t, nextID, item.LeaseID)
 		require.WithinDuration(t, now.Add(10*time.Second), ulid.Time(item.LeaseID.Time()), 20*time.Millisecond)
+		// The item is scored by its lease's expiry, in milliseconds.
+		requireItemScoreEquals(t, r, item, ulid.Time(nextID.Time()))
 
 		t.Run("It fails with an invalid lease ID", func(t *testing.T) {
 			invalid := ulid.MustNew(ulid.Now(), rnd)
@@ -395,7 +603,7 @@ func TestQueueDequeue(t *testing.T) {
 		item, err := q.EnqueueItem(ctx, QueueItem{}, start)
 		require.NoError(t, err)
 
-		id, err := q.Lease(ctx, item.WorkflowID, item.ID, time.Second)
+		id, err := q.Lease(ctx, item, time.Second)
 		require.NoError(t, err)
 
 		err = q.Dequeue(ctx, item)
@@ -449,7 +657,7 @@ func TestQueueRequeue(t *testing.T) {
 
 		item, err := q.EnqueueItem(ctx, QueueItem{}, now)
 		require.NoError(t, err)
-		_, err = q.Lease(ctx, item.WorkflowID, item.ID, time.Second)
+		_, err = q.Lease(ctx, item, time.Second)
 		require.NoError(t, err)
 
 		// Assert partition index is original
@@ -679,7 +887,7 @@ func TestQueuePartitionRequeue(t *testing.T) {
 	})
 
 	t.Run("Requeus the partition with a leased job", func(t *testing.T) {
-		_, err := q.Lease(ctx, idA, qi.ID, 10*time.Second)
+		_, err := q.Lease(ctx, qi, 10*time.Second)
 		require.NoError(t, err)
 
 		requirePartitionScoreEquals(t, r, idA, now)
@@ -810,6 +1018,50 @@ func TestQueueLeaseSequential(t *testing.T) {
 	})
 }
 
+func TestQueueConfig(t *testing.T) {
+	r := miniredis.RunT(t)
+	ctx := context.Background()
+
+	c := QueueConfig{
+		Host:           r.Host(),
+		Port:           mustAtoi(t, r.Port()),
+		KeyPrefix:      "test",
+		NumWorkers:     5,
+		PollTick:       "5ms",
+		IdempotencyTTL: "1h",
+		MaxJobDuration: "10m",
+	}
+	require.Equal(t, "redis", c.QueueName())
+
+	created, err := c.Queue()
+	require.NoError(t, err)
+	q, ok := created.(*queue)
+	require.True(t, ok)
+	require.EqualValues(t, 5, q.numWorkers)
+	require.Equal(t, 5*time.Millisecond, q.pollTick)
+	require.Equal(t, time.Hour, q.idempotencyTTL)
+	require.Equal(t, 10*time.Minute, q.maxJobDuration)
+
+	// Items must be written using the configured key prefix.
+	item, err := q.EnqueueItem(ctx, QueueItem{}, time.Now())
+	require.NoError(t, err)
+	kg := DefaultQueueKeyGenerator{Prefix: "test"}
+	require.NotEmpty(t, r.HGet(kg.QueueItem(), item.ID))
+
+	t.Run("It errors with invalid durations", func(t *testing.T) {
+		c.PollTick = "nope"
+		_, err := c.Queue()
+		require.Error(t, err)
+	})
+}
+
+func mustAtoi(t *testing.T, s string) int {
+	t.Helper()
+	i, err := strconv.Atoi(s)
+	require.NoError(t, err)
+	return i
+}
+
 func getQueueItem(t *testing.T, r *miniredis.Miniredis, id string) QueueItem {
 	t.Helper()
 	// Ensure that our data is set up correctly.
@@ -24,6 +24,10 @@ import (
 //go:embed lua/*
 var embedded embed.FS
 
+// debounceExpiryGrace is the period after a debounce's period elapses for which
+// the debounce is stored, allowing its queue item to be processed late.
+const debounceExpiryGrace = 24 * time.Hour
+
 var (
 	// scripts stores all embedded lua scripts on initialization
 	scripts = map[string]*redis.Script{}
@@ -33,6 +37,8 @@ var (
 func init() {
 	// register the redis driver
 	registration.RegisterState(func() any { return &Config{} })
+	// register the redis queue
+	registration.RegisterQueue(func() any { return &QueueConfig{} })
 
 	// read the lua scripts
 	entries, err := embedded.ReadDir("lua")
@@ -147,7 +153,8 @@ type Opt func(r *mgr)
 // change how we connect to Redis.
 func New(ctx context.Context, opts ...Opt) (state.Manager, error) {
 	m := &mgr{
-		kf: DefaultKeyFunc{},
+		kf:        DefaultKeyFunc{},
+		callbacks: &state.FunctionCallbacks{},
 	}
 
 	for _, opt := range opts {
@@ -207,7 +214,7 @@ func WithExpiration(ttl time.Duration) Opt {
 // run completes.
 func WithFunctionCallbacks(f ...state.FunctionCallback) Opt {
 	return func(m *mgr) {
-		m.callbacks = f
+		m.callbacks.Add(f...)
 	}
 }
 
@@ -216,13 +223,13 @@ type mgr struct {
 	kf     KeyGenerator
 	r      *redis.Client
 
-	callbacks []state.FunctionCallback
+	callbacks *state.FunctionCallbacks
 }
 
 // OnFunctionStatus adds a callback to be called whenever functions
 // transition status.
 func (m *mgr) OnFunctionStatus(f state.FunctionCallback) {
-	m.callbacks = append(m.callbacks, f)
+	m.callbacks.Add(f)
 }
 
 func (m mgr) New(ctx context.Context, input state.Input) (state.State, error) {
@@ -238,11 +245,24 @@ func (m mgr) New(ctx context.Context, input state.Input) (state.State, error) {
 	if err != nil {
 		return nil, err
 	}
+	var batch []byte
+	if len(input.Events) > 0 {
+		batch, err = json.Marshal(input.Events)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	metadata := runMetadata{
 		Identifier: input.Identifier,
 		Pending:    1,
 		Debugger:   input.Debugger,
 		Context:    input.Context,
+		EventIDs:   input.EventIDs,
+	}
+	if input.Skipped {
+		metadata.Status = enums.RunStatusSkipped
+		metadata.Pending = 0
 	}
 	if input.OriginalRunID != nil {
 		metadata.OriginalRunID = input.OriginalRunID.String()
@@ -270,6 +290,22 @@ func (m mgr) New(ctx context.Context, input state.Input) (state.State, error) {
 		CreatedAt:  time.UnixMilli(int64(input.Identifier.RunID.Time())),
 	}
 
+	skipped := 0
+	if input.Skipped {
+		skipped = 1
+		history.Type = enums.HistoryTypeFunctionSkipped
+		history.Data = state.HistoryFunctionSkipped{
+			Key:   input.Identifier.Key,
+			Event: input.EventData,
+		}
+	}
+
+	// Runs which started before this time have expired state.
+	expiredBefore := int64(0)
+	if m.expiry > 0 {
+		expiredBefore = history.CreatedAt.Add(-m.expiry).UnixMilli()
+	}
+
 	status, err := scripts["new"].Eval(
 		ctx,
 		m.r,
@@ -280,6 +316,8 @@ func (m mgr) New(ctx context.Context, input state.Input) (state.State, error) {
 			m.kf.RunMetadata(ctx, input.Identifier.RunID),
 			m.kf.Actions(ctx, input.Identifier),
 			m.kf.History(ctx, input.Identifier.RunID),
+			m.kf.Batch(ctx, input.Identifier),
+			m.kf.FunctionRuns(ctx, input.Identifier.WorkflowID),
 		},
 		event,
 		workflow,
@@ -288,6 +326,11 @@ func (m mgr) New(ctx context.Context, input state.Input) (state.State, error) {
 		m.expiry,
 		history,
 		history.CreatedAt.UnixMilli(),
+		skipped,
+		input.IdempotencyTTL.Milliseconds(),
+		batch,
+		input.Identifier.RunID.String(),
+		expiredBefore,
 	).Int64()
 
 	if err != nil {
@@ -298,13 +341,14 @@ func (m mgr) New(ctx context.Context, input state.Input) (state.State, error) {
 		return nil, state.ErrIdentifierExists
 	}
 
-	go m.runCallbacks(ctx, input.Identifier, enums.RunStatusRunning)
+	m.callbacks.Run(input.Identifier, enums.RunStatusRunning)
 
 	return inmemory.NewStateInstance(
 			input.Workflow,
 			input.Identifier,
 			metadata.Metadata(),
 			input.EventData,
+			input.Events,
 			input.Steps,
 			map[string]error{},
 		),
@@ -327,18 +371,32 @@ func (m mgr) metadata(ctx context.Context, runID ulid.ULID) (*runMetadata, error
 	return NewRunMetadata(val)
 }
 
-func (m mgr) Cancel(ctx context.Context, id state.Identifier) error {
+func (m mgr) Cancel(ctx context.Context, id state.Identifier, c state.HistoryFunctionCancelled) error {
+	now := time.Now()
 	status, err := scripts["cancel"].Eval(
 		ctx,
 		m.r,
-		[]string{m.kf.RunMetadata(ctx, id.RunID)},
+		[]string{
+			m.kf.RunMetadata(ctx, id.RunID),
+			m.kf.RunPauses(ctx, id.RunID),
+			m.kf.History(ctx, id.RunID),
+			m.kf.FunctionRuns(ctx, id.WorkflowID),
+		},
+		state.History{
+			Type:       enums.HistoryTypeFunctionCancelled,
+			Identifier: id,
+			CreatedAt:  now,
+			Data:       c,
+		},
+		now.UnixMilli(),
+		id.RunID.String(),
 	).Int64()
 	if err != nil {
 		return fmt.Errorf("error cancelling: %w", err)
 	}
 	switch status {
 	case 0:
-		go m.runCallbacks(ctx, id, enums.RunStatusCancelled)
+		m.callbacks.Run(id, enums.RunStatusCancelled)
 		return nil
 	case 1:
 		return state.ErrFunctionComplete
@@ -384,6 +442,18 @@ func (m mgr) Load(ctx context.Context, runID ulid.ULID) (state.State, error) {
 		return nil, fmt.Errorf("failed to unmarshal event; %w", err)
 	}
 
+	// Load the batch of events, which only exists for batched runs.
+	var events []map[string]any
+	byt, err = m.r.Get(ctx, m.kf.Batch(ctx, id)).Bytes()
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to get event batch; %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(byt, &events); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event batch; %w", err)
+		}
+	}
+
 	// Load the actions.  This is a map of step IDs to JSON-encoded results.
 	rmap, err := m.r.HGetAll(ctx, m.kf.Actions(ctx, id)).Result()
 	if err != nil {
@@ -412,7 +482,7 @@ func (m mgr) Load(ctx context.Context, runID ulid.ULID) (state.State, error) {
 
 	meta := metadata.Metadata()
 
-	return inmemory.NewStateInstance(*w, id, meta, event, actions, errors), nil
+	return inmemory.NewStateInstance(*w, id, meta, event, events, actions, errors), nil
 }
 
 func (m mgr) SaveResponse(ctx context.Context, i state.Identifier, r state.DriverResponse, attempt int) (state.State, error) {
@@ -432,6 +502,9 @@ func (m mgr) SaveResponse(ctx context.Context, i state.Identifier, r state.Drive
 		}
 	} else {
 		typ = enums.HistoryTypeStepErrored
+		if r.TimedOut() {
+			typ = enums.HistoryTypeStepTimedOut
+		}
 		data = r.Err.Error()
 		if r.Final() {
 			typ = enums.HistoryTypeStepFailed
@@ -463,6 +536,7 @@ func (m mgr) SaveResponse(ctx context.Context, i state.Identifier, r state.Drive
 			m.kf.Errors(ctx, i),
 			m.kf.RunMetadata(ctx, i.RunID),
 			m.kf.History(ctx, i.RunID),
+			m.kf.FunctionRuns(ctx, i.WorkflowID),
 		},
 		data,
 		r.Step.ID,
@@ -471,6 +545,7 @@ func (m mgr) SaveResponse(ctx context.Context, i state.Identifier, r state.Drive
 		stepHistory,
 		funcFailHistory,
 		now.UnixMilli(),
+		i.RunID.String(),
 	).Err()
 	if err != nil {
 		return nil, fmt.Errorf("error finalizing: %w", err)
@@ -478,7 +553,7 @@ func (m mgr) SaveResponse(ctx context.Context, i state.Identifier, r state.Drive
 
 	if r.Err != nil && r.Final() {
 		// Trigger error callbacks
-		go m.runCallbacks(ctx, i, enums.RunStatusFailed)
+		m.callbacks.Run(i, enums.RunStatusFailed)
 	}
 
 	return m.Load(ctx, i.RunID)
@@ -538,7 +613,12 @@ func (m mgr) Finalized(ctx context.Context, i state.Identifier, stepID string, a
 	status, err := scripts["finalize"].Eval(
 		ctx,
 		m.r,
-		[]string{m.kf.RunMetadata(ctx, i.RunID), m.kf.History(ctx, i.RunID)},
+		[]string{
+			m.kf.RunMetadata(ctx, i.RunID),
+			m.kf.History(ctx, i.RunID),
+			m.kf.RunPauses(ctx, i.RunID),
+			m.kf.FunctionRuns(ctx, i.WorkflowID),
+		},
 		state.History{
 			Type:       enums.HistoryTypeFunctionCompleted,
 			Identifier: i,
@@ -546,22 +626,86 @@ func (m mgr) Finalized(ctx context.Context, i state.Identifier, stepID string, a
 		},
 		now.UnixMilli(),
 		int(finalStatus),
+		i.RunID.String(),
 	).Int64()
 	if err != nil {
 		return fmt.Errorf("error finalizing: %w", err)
 	}
 	if status == 1 {
-		go m.runCallbacks(ctx, i, enums.RunStatusCompleted)
+		m.callbacks.Run(i, enums.RunStatusCompleted)
+	}
+	return nil
+}
+
+func (m mgr) Reported(ctx context.Context, i state.Identifier, groupID string, stepID string, size int) (bool, error) {
+	key := m.kf.Reported(ctx, i.RunID, groupID)
+
+	// Add the step and count the group's steps within a transaction, such that
+	// the report which adds the group's final step sees the group as complete.
+	var count *redis.IntCmd
+	_, err := m.r.TxPipelined(ctx, func(tx redis.Pipeliner) error {
+		tx.SAdd(ctx, key, stepID)
+		count = tx.SCard(ctx, key)
+		if m.expiry > 0 {
+			tx.Expire(ctx, key, m.expiry)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("error recording reported step: %w", err)
+	}
+	return count.Val() == int64(size), nil
+}
+
+func (m mgr) RecordEvent(ctx context.Context, eventID string, ttl time.Duration) (bool, error) {
+	added, err := m.r.SetNX(ctx, m.kf.ReceivedEvent(ctx, eventID), "", ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("error recording event: %w", err)
+	}
+	return added, nil
+}
+
+func (m mgr) ForgetEvent(ctx context.Context, eventID string) error {
+	if err := m.r.Del(ctx, m.kf.ReceivedEvent(ctx, eventID)).Err(); err != nil {
+		return fmt.Errorf("error forgetting event: %w", err)
 	}
 	return nil
 }
 
+// runPause stores the keys used by a pause within the run's pause hash, allowing
+// scripts to delete all pauses for a run when the run is cancelled or finishes.
+type runPause struct {
+	Pause     string `json:"pause"`
+	Step      string `json:"step"`
+	Lease     string `json:"lease"`
+	Event     string `json:"event"`
+	MatchKeys string `json:"matchKeys"`
+	MatchKey  string `json:"matchKey"`
+}
+
 func (m mgr) SavePause(ctx context.Context, p state.Pause) error {
 	packed, err := json.Marshal(p)
 	if err != nil {
 		return err
 	}
 
+	rp := runPause{
+		Pause: m.kf.PauseID(ctx, p.ID),
+		Step:  m.kf.PauseStep(ctx, p.Identifier, p.Incoming),
+		Lease: m.kf.PauseLease(ctx, p.ID),
+	}
+	if p.Event != nil {
+		rp.Event = m.pauseEventKey(ctx, p)
+	}
+	if p.Event != nil && p.MatchKey != nil {
+		rp.MatchKeys = m.kf.PauseEventMatchKeys(ctx, p.WorkspaceID, *p.Event)
+		rp.MatchKey = *p.MatchKey
+	}
+	runPauseByt, err := json.Marshal(rp)
+	if err != nil {
+		return err
+	}
+
 	return m.r.Watch(ctx, func(tx *redis.Tx) error {
 		err := tx.SetNX(
 			ctx,
@@ -595,13 +739,34 @@ func (m mgr) SavePause(ctx context.Context, p state.Pause) error {
 		//
 		// NOTE: Because we return an iterator to this set directly for returning pauses
 		// matching an event, we must store the pause within this event again.
+		//
+		// Pauses with a match key are stored in a separate hash per match key, so
+		// that events only need to load pauses that they may match.
 		if p.Event != nil {
-			if err = tx.HSet(ctx, m.kf.PauseEvent(ctx, p.WorkspaceID, *p.Event), map[string]any{
+			if err = tx.HSet(ctx, m.pauseEventKey(ctx, p), map[string]any{
 				p.ID.String(): string(packed),
 			}).Err(); err != nil {
 				return err
 			}
 		}
+		if p.Event != nil && p.MatchKey != nil {
+			if err = tx.SAdd(ctx, m.kf.PauseEventMatchKeys(ctx, p.WorkspaceID, *p.Event), *p.MatchKey).Err(); err != nil {
+				return err
+			}
+		}
+
+		// Record the pause's keys against the run, so that the run's pauses are
+		// deleted when the run is cancelled or finishes.
+		if err = tx.HSet(ctx, m.kf.RunPauses(ctx, p.Identifier.RunID), map[string]any{
+			p.ID.String(): string(runPauseByt),
+		}).Err(); err != nil {
+			return err
+		}
+		if m.expiry > 0 {
+			if err = tx.Expire(ctx, m.kf.RunPauses(ctx, p.Identifier.RunID), m.expiry).Err(); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -642,15 +807,21 @@ func (m mgr) ConsumePause(ctx context.Context, id uuid.UUID, data any) error {
 		return fmt.Errorf("cannot marshal data to store in state: %w", err)
 	}
 
-	eventKey := ""
+	eventKey, matchKeysKey, matchKey := "", "", ""
 	if p.Event != nil {
-		eventKey = m.kf.PauseEvent(ctx, p.WorkspaceID, *p.Event)
+		eventKey = m.pauseEventKey(ctx, *p)
+	}
+	if p.Event != nil && p.MatchKey != nil {
+		matchKeysKey = m.kf.PauseEventMatchKeys(ctx, p.WorkspaceID, *p.Event)
+		matchKey = *p.MatchKey
 	}
 	keys := []string{
 		m.kf.PauseID(ctx, id),
 		m.kf.PauseStep(ctx, p.Identifier, p.Incoming),
 		eventKey,
 		m.kf.Actions(ctx, p.Identifier),
+		matchKeysKey,
+		m.kf.RunPauses(ctx, p.Identifier.RunID),
 	}
 
 	status, err := scripts["consumePause"].Eval(
@@ -661,6 +832,7 @@ func (m mgr) ConsumePause(ctx context.Context, id uuid.UUID, data any) error {
 		id.String(),
 		p.DataKey,
 		string(marshalledData),
+		matchKey,
 	).Int64()
 	if err != nil {
 		return fmt.Errorf("error consuming pause: %w", err)
@@ -677,17 +849,79 @@ func (m mgr) ConsumePause(ctx context.Context, id uuid.UUID, data any) error {
 
 // PausesByEvent returns all pauses for a given event within a workspace.
 func (m mgr) PausesByEvent(ctx context.Context, workspaceID uuid.UUID, event string) (state.PauseIterator, error) {
-	cmd := m.r.HScan(ctx, m.kf.PauseEvent(ctx, workspaceID, event), 0, "", 0)
-	if err := cmd.Err(); err != nil {
+	matchKeys, err := m.r.SMembers(ctx, m.kf.PauseEventMatchKeys(ctx, workspaceID, event)).Result()
+	if err != nil {
 		return nil, err
 	}
+	return m.pausesByEventMatch(ctx, workspaceID, event, matchKeys)
+}
+
+// PausesByEventMatch returns all pauses for a given event within a workspace
+// which have no match key or have one of the given match keys.
+func (m mgr) PausesByEventMatch(ctx context.Context, workspaceID uuid.UUID, event string, keys []string) (state.PauseIterator, error) {
+	return m.pausesByEventMatch(ctx, workspaceID, event, keys)
+}
+
+func (m mgr) pausesByEventMatch(ctx context.Context, workspaceID uuid.UUID, event string, matchKeys []string) (state.PauseIterator, error) {
+	keys := []string{m.kf.PauseEvent(ctx, workspaceID, event)}
+	for _, mk := range matchKeys {
+		keys = append(keys, m.kf.PauseEventMatch(ctx, workspaceID, event, mk))
+	}
+	return &iter{r: m.r, keys: keys}, nil
+}
 
-	i := cmd.Iterator()
-	if i == nil {
-		return nil, fmt.Errorf("unable to create event iterator")
+// pauseEventKey returns the hash that the given event pause is stored within.
+func (m mgr) pauseEventKey(ctx context.Context, p state.Pause) string {
+	if p.MatchKey == nil {
+		return m.kf.PauseEvent(ctx, p.WorkspaceID, *p.Event)
 	}
+	return m.kf.PauseEventMatch(ctx, p.WorkspaceID, *p.Event, *p.MatchKey)
+}
 
-	return &iter{ri: i}, nil
+func (m mgr) SaveDebounce(ctx context.Context, d state.Debounce) error {
+	byt, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("error marshalling debounce: %w", err)
+	}
+	// Expire the debounce once it can no longer be consumed, such that unused
+	// debounces don't persist forever.
+	ttl := d.Period + debounceExpiryGrace
+	if err := m.r.Set(ctx, m.kf.Debounce(ctx, d.Key), byt, ttl).Err(); err != nil {
+		return fmt.Errorf("error saving debounce: %w", err)
+	}
+	return nil
+}
+
+func (m mgr) RestoreDebounce(ctx context.Context, d state.Debounce) error {
+	byt, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("error marshalling debounce: %w", err)
+	}
+	ttl := d.Period + debounceExpiryGrace
+	if err := m.r.SetNX(ctx, m.kf.Debounce(ctx, d.Key), byt, ttl).Err(); err != nil {
+		return fmt.Errorf("error restoring debounce: %w", err)
+	}
+	return nil
+}
+
+func (m mgr) ConsumeDebounce(ctx context.Context, key string, id ulid.ULID) (*state.Debounce, error) {
+	byt, err := scripts["consumeDebounce"].Eval(
+		ctx,
+		m.r,
+		[]string{m.kf.Debounce(ctx, key)},
+		id.String(),
+	).Text()
+	if err == redis.Nil {
+		return nil, state.ErrDebounceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error consuming debounce: %w", err)
+	}
+	d := &state.Debounce{}
+	if err := json.Unmarshal([]byte(byt), d); err != nil {
+		return nil, fmt.Errorf("error unmarshalling debounce: %w", err)
+	}
+	return d, nil
 }
 
 func (m mgr) PauseByID(ctx context.Context, id uuid.UUID) (*state.Pause, error) {
@@ -761,28 +995,94 @@ func (m mgr) History(ctx context.Context, runID ulid.ULID) ([]state.History, err
 	return history, nil
 }
 
-func (m mgr) runCallbacks(ctx context.Context, id state.Identifier, status enums.RunStatus) {
-	// Give all callbacks 5 seconds to run in total.
-	callCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	for _, f := range m.callbacks {
-		go func(fn state.FunctionCallback) {
-			fn(callCtx, id, status)
-		}(f)
+// RunningRuns returns the identifiers of a function's running runs which started
+// within the given time range.
+func (m mgr) RunningRuns(ctx context.Context, workflowID uuid.UUID, from, to time.Time) ([]state.Identifier, error) {
+	key := m.kf.FunctionRuns(ctx, workflowID)
+	runIDs, err := m.r.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min: strconv.FormatInt(from.UnixMilli(), 10),
+		// The range excludes runs started at the end time.
+		Max: "(" + strconv.FormatInt(to.UnixMilli(), 10),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("error listing runs: %w", err)
+	}
+	if len(runIDs) == 0 {
+		return nil, nil
+	}
+
+	pipe := m.r.Pipeline()
+	cmds := make([]*redis.StringStringMapCmd, len(runIDs))
+	for n, id := range runIDs {
+		runID, err := ulid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid run ID stored for function: %s", id)
+		}
+		cmds[n] = pipe.HGetAll(ctx, m.kf.RunMetadata(ctx, runID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("error loading run metadata: %w", err)
+	}
+
+	ids := []state.Identifier{}
+	finished := []any{}
+	for n, cmd := range cmds {
+		if len(cmd.Val()) == 0 {
+			// The run's state has expired.
+			finished = append(finished, runIDs[n])
+			continue
+		}
+		md, err := NewRunMetadata(cmd.Val())
+		if err != nil {
+			return nil, err
+		}
+		if md.Status != enums.RunStatusRunning {
+			finished = append(finished, runIDs[n])
+			continue
+		}
+		ids = append(ids, md.Identifier)
+	}
+
+	// Runs never start running again once they finish, so remove them from the
+	// index.
+	if len(finished) > 0 {
+		if err := m.r.ZRem(ctx, key, finished...).Err(); err != nil {
+			return nil, fmt.Errorf("error removing finished runs: %w", err)
+		}
 	}
+	return ids, nil
 }
 
+// iter iterates through pauses stored within one or more hashes, scanning
+// each hash in turn.
 type iter struct {
-	ri *redis.ScanIterator
+	r    *redis.Client
+	keys []string
+	ri   *redis.ScanIterator
 }
 
 func (i *iter) Next(ctx context.Context) bool {
-	// Skip over the key;  we're using HScan which returns key then value.
-	_ = i.ri.Next(ctx)
-	return i.ri.Next(ctx)
+	for {
+		if i.ri == nil {
+			if len(i.keys) == 0 {
+				return false
+			}
+			i.ri = i.r.HScan(ctx, i.keys[0], 0, "", 0).Iterator()
+			i.keys = i.keys[1:]
+		}
+
+		// Skip over the key;  we're using HScan which returns key then value.
+		if i.ri.Next(ctx) && i.ri.Next(ctx) {
+			return true
+		}
+		i.ri = nil
+	}
 }
 
 func (i *iter) Val(ctx context.Context) *state.Pause {
+	if i.ri == nil {
+		return nil
+	}
 	val := i.ri.Val()
 	if val == "" {
 		return nil
@@ -844,6 +1144,13 @@ func NewRunMetadata(data map[string]string) (*runMetadata, error) {
 		}
 		m.Context = ctx
 	}
+	if val, ok := data["eventIDs"]; ok && val != "" {
+		ids := []string{}
+		if err := json.Unmarshal([]byte(val), &ids); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal metadata event IDs: %s", val)
+		}
+		m.EventIDs = ids
+	}
 
 	return m, nil
 }
@@ -860,6 +1167,7 @@ type runMetadata struct {
 	RunType       string         `json:"runType,omitempty"`
 	OriginalRunID string         `json:"originalRunID,omitempty"`
 	Context       map[string]any `json:"ctx,omitempty"`
+	EventIDs      []string       `json:"eventIDs,omitempty"`
 }
 
 func (r runMetadata) Map() map[string]any {
@@ -871,6 +1179,7 @@ func (r runMetadata) Map() map[string]any {
 		"runType":       r.RunType,
 		"originalRunID": r.OriginalRunID,
 		"ctx":           r.Context,
+		"eventIDs":      r.EventIDs,
 	}
 }
 
@@ -881,6 +1190,7 @@ func (r runMetadata) Metadata() state.Metadata {
 		Debugger:   r.Debugger,
 		Status:     r.Status,
 		Context:    r.Context,
+		EventIDs:   r.EventIDs,
 	}
 
 	if r.RunType != "" {
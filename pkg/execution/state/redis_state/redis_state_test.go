@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/go-redis/redis/v8"
@@ -42,6 +43,74 @@ func TestStateHarness(t *testing.T) {
 	testharness.CheckState(t, create)
 }
 
+func TestSaveDebounceExpiry(t *testing.T) {
+	ctx := context.Background()
+	r := miniredis.RunT(t)
+	sm, err := New(ctx, WithConnectOpts(redis.Options{Addr: r.Addr()}))
+	require.NoError(t, err)
+
+	d := state.Debounce{
+		ID:     ulid.MustNew(ulid.Now(), rand.Reader),
+		Key:    "fn-debounce-key",
+		Period: time.Minute,
+	}
+	err = sm.SaveDebounce(ctx, d)
+	require.NoError(t, err)
+
+	key := DefaultKeyFunc{}.Debounce(ctx, d.Key)
+	require.Equal(t, time.Minute+debounceExpiryGrace, r.TTL(key))
+}
+
+func TestFunctionRunsIndex(t *testing.T) {
+	ctx := context.Background()
+	r := miniredis.RunT(t)
+	sm, err := New(ctx, WithConnectOpts(redis.Options{Addr: r.Addr()}), WithExpiration(time.Hour))
+	require.NoError(t, err)
+
+	workflowID := uuid.New()
+	key := DefaultKeyFunc{}.FunctionRuns(ctx, workflowID)
+	newRun := func(at time.Time) state.Identifier {
+		id := state.Identifier{
+			WorkflowID: workflowID,
+			RunID:      ulid.MustNew(ulid.Timestamp(at), rand.Reader),
+		}
+		_, err := sm.New(ctx, state.Input{
+			Identifier: id,
+			Workflow:   inngest.Workflow{UUID: workflowID},
+			EventData:  event.Event{Name: "test-event"}.Map(),
+		})
+		require.NoError(t, err)
+		return id
+	}
+	indexed := func() []string {
+		ids, err := r.ZMembers(key)
+		require.NoError(t, err)
+		return ids
+	}
+
+	t.Run("It removes runs with expired state when adding runs", func(t *testing.T) {
+		expired := newRun(time.Now().Add(-2 * time.Hour))
+		require.Equal(t, []string{expired.RunID.String()}, indexed())
+
+		running := newRun(time.Now())
+		require.Equal(t, []string{running.RunID.String()}, indexed())
+	})
+
+	t.Run("It removes finished runs", func(t *testing.T) {
+		r.FlushAll()
+		completed := newRun(time.Now())
+		cancelled := newRun(time.Now())
+		running := newRun(time.Now())
+		require.Len(t, indexed(), 3)
+
+		err := sm.Finalized(ctx, completed, "step", 0)
+		require.NoError(t, err)
+		err = sm.Cancel(ctx, cancelled, state.HistoryFunctionCancelled{})
+		require.NoError(t, err)
+		require.Equal(t, []string{running.RunID.String()}, indexed())
+	})
+}
+
 func BenchmarkNew(b *testing.B) {
 	r := miniredis.RunT(b)
 	sm, err := New(
@@ -0,0 +1,106 @@
+-- The SQLite state store schema, adapted from the Postgres state store's
+-- migrations.  All times are stored as millisecond epochs.
+
+-- state_runs stores the metadata for each function run, including the pending
+-- step count used to determine when a run completes.
+CREATE TABLE IF NOT EXISTS state_runs (
+  run_id text NOT NULL,
+  workflow_id text NOT NULL,
+  workflow_version integer NOT NULL,
+  -- the JSON-encoded state.Identifier for the run
+  identifier text NOT NULL,
+  status integer NOT NULL DEFAULT 0,
+  pending integer NOT NULL DEFAULT 0,
+  debugger boolean NOT NULL DEFAULT false,
+  run_type text,
+  original_run_id text,
+  -- JSON-encoded metadata context
+  context text,
+  -- JSON-encoded array of triggering event IDs
+  event_ids text,
+  -- the JSON-encoded triggering event
+  event text NOT NULL,
+  -- the JSON-encoded batch of triggering events, for batched runs only
+  batch text,
+  created_at integer NOT NULL,
+  PRIMARY KEY (run_id)
+);
+
+CREATE INDEX IF NOT EXISTS state_runs_workflow ON state_runs (workflow_id, workflow_version);
+
+-- state_idempotency_keys ensures that each idempotency key starts a single run.
+CREATE TABLE IF NOT EXISTS state_idempotency_keys (
+  key text NOT NULL,
+  -- keys with a null expiry are held forever
+  expires_at integer,
+  PRIMARY KEY (key)
+);
+
+-- state_workflows stores the configuration for each workflow version that has
+-- been run.
+CREATE TABLE IF NOT EXISTS state_workflows (
+  workflow_id text NOT NULL,
+  workflow_version integer NOT NULL,
+  workflow text NOT NULL,
+  PRIMARY KEY (workflow_id, workflow_version)
+);
+
+-- state_steps stores the output and latest error for each step of a run.
+CREATE TABLE IF NOT EXISTS state_steps (
+  run_id text NOT NULL,
+  step_id text NOT NULL,
+  -- JSON-encoded step output, null if the step has no output
+  output text,
+  -- the step's latest error, null if the step has not errored
+  error text,
+  PRIMARY KEY (run_id, step_id)
+);
+
+-- state_history stores the JSON-encoded history log for each run.
+CREATE TABLE IF NOT EXISTS state_history (
+  id integer PRIMARY KEY AUTOINCREMENT,
+  run_id text NOT NULL,
+  created_at integer NOT NULL,
+  data text NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS state_history_run ON state_history (run_id, created_at, id);
+
+-- state_pauses stores unconsumed pauses.
+CREATE TABLE IF NOT EXISTS state_pauses (
+  id text NOT NULL,
+  workspace_id text NOT NULL,
+  run_id text NOT NULL,
+  incoming text NOT NULL,
+  event text,
+  -- the JSON-encoded state.Pause
+  data text NOT NULL,
+  expires_at integer NOT NULL,
+  leased_until integer,
+  created_at integer NOT NULL,
+  -- the attribute value that an event must contain to match the pause, if any
+  match_key text,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS state_pauses_event ON state_pauses (workspace_id, event, id) WHERE event IS NOT NULL;
+CREATE INDEX IF NOT EXISTS state_pauses_event_match ON state_pauses (workspace_id, event, match_key, id) WHERE event IS NOT NULL;
+CREATE INDEX IF NOT EXISTS state_pauses_step ON state_pauses (run_id, incoming);
+
+-- state_debounces stores the pending run for each debounce key.
+CREATE TABLE IF NOT EXISTS state_debounces (
+  key text NOT NULL,
+  id text NOT NULL,
+  -- the JSON-encoded state.Debounce
+  data text NOT NULL,
+  PRIMARY KEY (key)
+);
+
+-- state_reported_steps stores the generator steps that have reported within
+-- each group of steps planned in parallel.
+CREATE TABLE IF NOT EXISTS state_reported_steps (
+  run_id text NOT NULL,
+  group_id text NOT NULL,
+  step_id text NOT NULL,
+  PRIMARY KEY (run_id, group_id, step_id)
+);
@@ -0,0 +1,48 @@
+package sqlite_state
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+
+	"github.com/inngest/inngest/pkg/config/registration"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/sqlstate"
+	"github.com/inngest/inngest/pkg/sqldialect"
+	"github.com/inngest/inngest/pkg/sqliteutil"
+)
+
+//go:embed schema.sql
+var schema string
+
+func init() {
+	registration.RegisterState(func() any { return &Config{} })
+}
+
+// Config registers the configuration for the SQLite state store, and provides
+// a factory for the state manager based off of the config.
+type Config struct {
+	// Path is the path to the SQLite database file, which is created if it
+	// doesn't exist.  This defaults to sqliteutil.DefaultPath.
+	Path string
+}
+
+func (c Config) StateName() string { return "sqlite" }
+
+func (c Config) Manager(ctx context.Context) (state.Manager, error) {
+	db, err := sqliteutil.Open(ctx, c.Path, schema)
+	if err != nil {
+		return nil, err
+	}
+	return sqlstate.New(db, sqldialect.SQLite), nil
+}
+
+// New returns a state manager which uses the given SQLite database as the
+// backing state store, creating the state store's schema if necessary.
+func New(ctx context.Context, db *sql.DB, opts ...sqlstate.Opt) (state.Manager, error) {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return nil, fmt.Errorf("error applying sqlite state schema: %w", err)
+	}
+	return sqlstate.New(db, sqldialect.SQLite, opts...), nil
+}
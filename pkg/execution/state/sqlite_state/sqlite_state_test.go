@@ -0,0 +1,46 @@
+package sqlite_state
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/testharness"
+	"github.com/inngest/inngest/pkg/sqliteutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateHarness(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := sqliteutil.Open(ctx, filepath.Join(t.TempDir(), "inngest.db"), schema)
+	require.NoError(t, err)
+	defer db.Close()
+
+	sm, err := New(ctx, db)
+	require.NoError(t, err)
+
+	create := func() (state.Manager, func()) {
+		return sm, func() {
+			for _, table := range []string{"state_runs", "state_idempotency_keys", "state_workflows", "state_steps", "state_history", "state_pauses", "state_debounces"} {
+				_, err := db.Exec("DELETE FROM " + table)
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	testharness.CheckState(t, create)
+}
+
+func TestNewCreatesSchema(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "inngest.db")
+
+	// Opening the same file twice must be safe, as the schema is applied
+	// each time the store is opened.
+	for i := 0; i < 2; i++ {
+		_, err := Config{Path: path}.Manager(ctx)
+		require.NoError(t, err)
+	}
+}
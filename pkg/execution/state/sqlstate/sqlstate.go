@@ -0,0 +1,1098 @@
+// Package sqlstate implements the state store using a SQL database.  The
+// Postgres and SQLite state stores wrap this package with their connection
+// config and schemas.
+package sqlstate
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngest/inngest"
+	"github.com/inngest/inngest/pkg/enums"
+	"github.com/inngest/inngest/pkg/execution/state"
+	"github.com/inngest/inngest/pkg/execution/state/inmemory"
+	"github.com/inngest/inngest/pkg/sqldialect"
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	// pauseExpiryGrace is the period after a pause expires during which the
+	// pause can still be loaded by ID, leased, and consumed.  This allows
+	// timeout edges to check whether the pause was consumed before expiring.
+	pauseExpiryGrace = 10 * time.Minute
+
+	// pausePageSize is the number of pauses loaded at once when iterating
+	// over pauses for an event.
+	pausePageSize = 500
+)
+
+// Opt represents an option to use when creating a SQL-backed state store.
+type Opt func(m *mgr)
+
+// New returns a state manager which stores state within the given database,
+// using queries for the given dialect.  The database's state tables must
+// exist.
+func New(db *sql.DB, dialect sqldialect.Dialect, opts ...Opt) state.Manager {
+	m := &mgr{
+		db:        db,
+		dialect:   dialect,
+		callbacks: &state.FunctionCallbacks{},
+
+		sqlLockRun:            fmt.Sprintf(sqlLockRun, dialect.ForUpdate),
+		sqlPausesByEventMatch: fmt.Sprintf(sqlPausesByEventMatch, dialect.In("match_key", "$7")),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// WithFunctionCallbacks supplies callbacks which are triggered any time a
+// function run changes status.
+func WithFunctionCallbacks(f ...state.FunctionCallback) Opt {
+	return func(m *mgr) {
+		m.callbacks.Add(f...)
+	}
+}
+
+var (
+	sqlInsertIdempotencyKey = `
+		INSERT INTO state_idempotency_keys (key, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET expires_at = excluded.expires_at
+		WHERE state_idempotency_keys.expires_at IS NOT NULL AND state_idempotency_keys.expires_at <= $3`
+	sqlDeleteIdempotencyKey = `
+		DELETE FROM state_idempotency_keys WHERE key = $1`
+	sqlInsertWorkflow = `
+		INSERT INTO state_workflows (workflow_id, workflow_version, workflow)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING`
+	sqlInsertRun = `
+		INSERT INTO state_runs (run_id, workflow_id, workflow_version, identifier, status, pending, debugger, run_type, original_run_id, context, event_ids, event, batch, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT DO NOTHING`
+	sqlLoadRun = `
+		SELECT r.identifier, r.status, r.pending, r.debugger, r.run_type, r.original_run_id, r.context, r.event_ids, r.event, r.batch, w.workflow
+		FROM state_runs r
+		JOIN state_workflows w ON w.workflow_id = r.workflow_id AND w.workflow_version = r.workflow_version
+		WHERE r.run_id = $1`
+	sqlRunPending = `
+		SELECT pending FROM state_runs WHERE run_id = $1`
+	sqlRunStatus = `
+		SELECT status FROM state_runs WHERE run_id = $1`
+	sqlRunningRuns = `
+		SELECT identifier FROM state_runs
+		WHERE workflow_id = $1 AND status = $2 AND created_at >= $3 AND created_at < $4
+		ORDER BY run_id`
+	sqlCancelRun = `
+		UPDATE state_runs SET status = $2
+		WHERE run_id = $1 AND status = $3`
+	sqlIncrPending = `
+		UPDATE state_runs SET pending = pending + 1
+		WHERE run_id = $1`
+	sqlDecrPending = `
+		UPDATE state_runs SET pending = pending - 1
+		WHERE run_id = $1
+		RETURNING pending, status`
+	sqlFailRun = `
+		UPDATE state_runs SET pending = pending - 1, status = $2
+		WHERE run_id = $1`
+	sqlSetRunStatus = `
+		UPDATE state_runs SET status = $2
+		WHERE run_id = $1`
+
+	// This is formatted with the dialect's ForUpdate clause.
+	sqlLockRun = `
+		SELECT status FROM state_runs WHERE run_id = $1 %s`
+
+	sqlInsertReportedStep = `
+		INSERT INTO state_reported_steps (run_id, group_id, step_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING`
+	sqlCountReportedSteps = `
+		SELECT COUNT(*) FROM state_reported_steps WHERE run_id = $1 AND group_id = $2`
+
+	sqlLoadSteps = `
+		SELECT step_id, output, error FROM state_steps WHERE run_id = $1`
+	sqlSaveStepOutput = `
+		INSERT INTO state_steps (run_id, step_id, output)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (run_id, step_id) DO UPDATE SET output = excluded.output`
+	sqlSaveStepError = `
+		INSERT INTO state_steps (run_id, step_id, error)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (run_id, step_id) DO UPDATE SET error = excluded.error`
+
+	sqlInsertHistory = `
+		INSERT INTO state_history (run_id, created_at, data)
+		VALUES ($1, $2, $3)`
+	sqlLoadHistory = `
+		SELECT data FROM state_history WHERE run_id = $1 ORDER BY created_at, id`
+
+	// Pauses are visible until their expiry grace period ends, or until
+	// their lease ends if leased past expiry.
+	sqlInsertPause = `
+		INSERT INTO state_pauses (id, workspace_id, run_id, incoming, event, data, expires_at, created_at, match_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT DO NOTHING`
+	sqlLeasePause = `
+		UPDATE state_pauses SET leased_until = $2
+		WHERE id = $1
+		AND (expires_at > $3 OR leased_until > $4)
+		AND (leased_until IS NULL OR leased_until <= $4)`
+	sqlConsumePause = `
+		DELETE FROM state_pauses
+		WHERE id = $1 AND (expires_at > $2 OR leased_until > $3)
+		RETURNING data`
+	sqlDeleteRunPauses = `
+		DELETE FROM state_pauses WHERE run_id = $1`
+	sqlPauseByID = `
+		SELECT data FROM state_pauses
+		WHERE id = $1 AND (expires_at > $2 OR leased_until > $3)`
+	sqlPauseByStep = `
+		SELECT data FROM state_pauses
+		WHERE run_id = $1 AND incoming = $2 AND expires_at > $3
+		ORDER BY created_at DESC
+		LIMIT 1`
+	sqlPausesByEvent = `
+		SELECT id, data FROM state_pauses
+		WHERE workspace_id = $1 AND event = $2
+		AND (expires_at > $3 OR leased_until > $4)
+		AND id > $5
+		ORDER BY id
+		LIMIT $6`
+	sqlPausesByEventUnmatched = `
+		SELECT id, data FROM state_pauses
+		WHERE workspace_id = $1 AND event = $2 AND match_key IS NULL
+		AND (expires_at > $3 OR leased_until > $4)
+		AND id > $5
+		ORDER BY id
+		LIMIT $6`
+	// The match keys are passed as an array, and this is formatted with the
+	// dialect's condition for matching any key.
+	sqlPausesByEventMatch = `
+		SELECT id, data FROM state_pauses
+		WHERE workspace_id = $1 AND event = $2 AND %s
+		AND (expires_at > $3 OR leased_until > $4)
+		AND id > $5
+		ORDER BY id
+		LIMIT $6`
+
+	sqlSaveDebounce = `
+		INSERT INTO state_debounces (key, id, data)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET id = excluded.id, data = excluded.data`
+	sqlRestoreDebounce = `
+		INSERT INTO state_debounces (key, id, data)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO NOTHING`
+	sqlConsumeDebounce = `
+		DELETE FROM state_debounces
+		WHERE key = $1 AND id = $2
+		RETURNING data`
+)
+
+type mgr struct {
+	db      *sql.DB
+	dialect sqldialect.Dialect
+
+	callbacks *state.FunctionCallbacks
+
+	// sqlLockRun and sqlPausesByEventMatch are the dialect's queries.
+	sqlLockRun            string
+	sqlPausesByEventMatch string
+}
+
+// OnFunctionStatus adds a callback to be called whenever functions
+// transition status.
+func (m *mgr) OnFunctionStatus(f state.FunctionCallback) {
+	m.callbacks.Add(f)
+}
+
+func (m *mgr) New(ctx context.Context, input state.Input) (state.State, error) {
+	event, err := json.Marshal(input.EventData)
+	if err != nil {
+		return nil, err
+	}
+	workflow, err := json.Marshal(input.Workflow)
+	if err != nil {
+		return nil, err
+	}
+	identifier, err := json.Marshal(input.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := nullJSON(len(input.Events) > 0, input.Events)
+	if err != nil {
+		return nil, err
+	}
+	runCtx, err := nullJSON(input.Context != nil, input.Context)
+	if err != nil {
+		return nil, err
+	}
+	eventIDs, err := nullJSON(len(input.EventIDs) > 0, input.EventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := state.Metadata{
+		Identifier:    input.Identifier,
+		Status:        enums.RunStatusRunning,
+		Pending:       1,
+		Debugger:      input.Debugger,
+		RunType:       input.RunType,
+		OriginalRunID: input.OriginalRunID,
+		Context:       input.Context,
+		EventIDs:      input.EventIDs,
+	}
+
+	history := state.History{
+		Type:       enums.HistoryTypeFunctionStarted,
+		Identifier: input.Identifier,
+		CreatedAt:  time.UnixMilli(int64(input.Identifier.RunID.Time())),
+	}
+
+	if input.Skipped {
+		metadata.Status = enums.RunStatusSkipped
+		metadata.Pending = 0
+		history.Type = enums.HistoryTypeFunctionSkipped
+		history.Data = state.HistoryFunctionSkipped{
+			Key:   input.Identifier.Key,
+			Event: input.EventData,
+		}
+	}
+
+	var originalRunID *string
+	if input.OriginalRunID != nil {
+		id := input.OriginalRunID.String()
+		originalRunID = &id
+	}
+
+	runID := input.Identifier.RunID.String()
+	now := time.Now()
+
+	err = m.tx(ctx, func(tx *sql.Tx) error {
+		// Skipped runs are stored for visibility only and never claim the
+		// idempotency key, but must still have a unique run ID.
+		if !input.Skipped {
+			var expires any
+			if input.IdempotencyTTL > 0 {
+				expires = m.dialect.Time(now.Add(input.IdempotencyTTL))
+			}
+			res, err := tx.ExecContext(ctx, sqlInsertIdempotencyKey, input.Identifier.IdempotencyKey(), expires, m.dialect.Time(now))
+			if err != nil {
+				return err
+			}
+			if n, err := res.RowsAffected(); err != nil || n == 0 {
+				return state.ErrIdentifierExists
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, sqlInsertWorkflow, input.Identifier.WorkflowID, input.Identifier.WorkflowVersion, string(workflow)); err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(
+			ctx,
+			sqlInsertRun,
+			runID,
+			input.Identifier.WorkflowID,
+			input.Identifier.WorkflowVersion,
+			string(identifier),
+			int(metadata.Status),
+			metadata.Pending,
+			input.Debugger,
+			input.RunType,
+			originalRunID,
+			runCtx,
+			eventIDs,
+			string(event),
+			batch,
+			m.dialect.Time(now),
+		)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return state.ErrIdentifierExists
+		}
+
+		for stepID, output := range input.Steps {
+			byt, err := json.Marshal(output)
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, sqlSaveStepOutput, runID, stepID, string(byt)); err != nil {
+				return err
+			}
+		}
+
+		return m.insertHistory(ctx, tx, history)
+	})
+	if err == state.ErrIdentifierExists {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error storing run state in %s: %w", m.dialect.Name, err)
+	}
+
+	m.callbacks.Run(input.Identifier, enums.RunStatusRunning)
+
+	return inmemory.NewStateInstance(
+			input.Workflow,
+			input.Identifier,
+			metadata,
+			input.EventData,
+			input.Events,
+			input.Steps,
+			map[string]error{},
+		),
+		nil
+}
+
+func (m *mgr) IsComplete(ctx context.Context, runID ulid.ULID) (bool, error) {
+	var pending int
+	if err := m.db.QueryRowContext(ctx, sqlRunPending, runID.String()).Scan(&pending); err != nil {
+		return false, err
+	}
+	return pending == 0, nil
+}
+
+func (m *mgr) Cancel(ctx context.Context, id state.Identifier, c state.HistoryFunctionCancelled) error {
+	cancelled := false
+	err := m.tx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, sqlCancelRun, id.RunID.String(), int(enums.RunStatusCancelled), int(enums.RunStatusRunning))
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil || n != 1 {
+			return err
+		}
+		cancelled = true
+		// Cancelled runs can no longer be resumed, so remove all outstanding
+		// pauses.
+		if _, err := tx.ExecContext(ctx, sqlDeleteRunPauses, id.RunID.String()); err != nil {
+			return err
+		}
+		return m.insertHistory(ctx, tx, state.History{
+			Type:       enums.HistoryTypeFunctionCancelled,
+			Identifier: id,
+			CreatedAt:  time.Now(),
+			Data:       c,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error cancelling: %w", err)
+	}
+	if cancelled {
+		m.callbacks.Run(id, enums.RunStatusCancelled)
+		return nil
+	}
+
+	var status int
+	if err := m.db.QueryRowContext(ctx, sqlRunStatus, id.RunID.String()).Scan(&status); err != nil {
+		return fmt.Errorf("error cancelling: %w", err)
+	}
+	switch enums.RunStatus(status) {
+	case enums.RunStatusCompleted:
+		return state.ErrFunctionComplete
+	case enums.RunStatusFailed:
+		return state.ErrFunctionFailed
+	case enums.RunStatusCancelled:
+		return state.ErrFunctionCancelled
+	}
+	return fmt.Errorf("unknown status cancelling function: %d", status)
+}
+
+// RunningRuns returns the identifiers of a function's running runs which started
+// within the given time range.
+func (m *mgr) RunningRuns(ctx context.Context, workflowID uuid.UUID, from, to time.Time) ([]state.Identifier, error) {
+	rows, err := m.db.QueryContext(ctx, sqlRunningRuns, workflowID, int(enums.RunStatusRunning), m.dialect.Time(from), m.dialect.Time(to))
+	if err != nil {
+		return nil, fmt.Errorf("error loading runs: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []state.Identifier{}
+	for rows.Next() {
+		var packed string
+		if err := rows.Scan(&packed); err != nil {
+			return nil, err
+		}
+		id := state.Identifier{}
+		if err := json.Unmarshal([]byte(packed), &id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (m *mgr) Load(ctx context.Context, runID ulid.ULID) (state.State, error) {
+	var (
+		identifier, event, workflow                     string
+		status, pending                                 int
+		debugger                                        bool
+		runType, originalRunID, runCtx, eventIDs, batch sql.NullString
+	)
+
+	err := m.db.QueryRowContext(ctx, sqlLoadRun, runID.String()).Scan(
+		&identifier,
+		&status,
+		&pending,
+		&debugger,
+		&runType,
+		&originalRunID,
+		&runCtx,
+		&eventIDs,
+		&event,
+		&batch,
+		&workflow,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metadata; %w", err)
+	}
+
+	metadata := state.Metadata{
+		Status:   enums.RunStatus(status),
+		Pending:  pending,
+		Debugger: debugger,
+	}
+	if err := json.Unmarshal([]byte(identifier), &metadata.Identifier); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal metadata identifier: %w", err)
+	}
+	if runType.Valid {
+		metadata.RunType = &runType.String
+	}
+	if originalRunID.Valid {
+		id, err := ulid.Parse(originalRunID.String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid original run ID stored in metadata: %w", err)
+		}
+		metadata.OriginalRunID = &id
+	}
+	if runCtx.Valid {
+		if err := json.Unmarshal([]byte(runCtx.String), &metadata.Context); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal metadata context: %w", err)
+		}
+	}
+	if eventIDs.Valid {
+		if err := json.Unmarshal([]byte(eventIDs.String), &metadata.EventIDs); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal metadata event IDs: %w", err)
+		}
+	}
+
+	id := metadata.Identifier
+
+	w := &inngest.Workflow{}
+	if err := json.Unmarshal([]byte(workflow), w); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workflow; %w", err)
+	}
+	// We must ensure that the workflow UUID and Version are marshalled in JSON.
+	// In the dev server these are blank, so we force-add them here.
+	w.UUID = id.WorkflowID
+	w.Version = id.WorkflowVersion
+
+	evt := map[string]any{}
+	if err := json.Unmarshal([]byte(event), &evt); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event; %w", err)
+	}
+
+	// The batch of events only exists for batched runs.
+	var events []map[string]any
+	if batch.Valid {
+		if err := json.Unmarshal([]byte(batch.String), &events); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event batch; %w", err)
+		}
+	}
+
+	rows, err := m.db.QueryContext(ctx, sqlLoadSteps, runID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed loading actions; %w", err)
+	}
+	defer rows.Close()
+
+	actions := map[string]any{}
+	errors := map[string]error{}
+	for rows.Next() {
+		var (
+			stepID          string
+			output, stepErr sql.NullString
+		)
+		if err := rows.Scan(&stepID, &output, &stepErr); err != nil {
+			return nil, fmt.Errorf("failed loading actions; %w", err)
+		}
+		if output.Valid {
+			var data any
+			if err := json.Unmarshal([]byte(output.String), &data); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal step \"%s\" with data \"%s\"; %w", stepID, output.String, err)
+			}
+			actions[stepID] = data
+		}
+		if stepErr.Valid {
+			// The original error type is not preserved.
+			errors[stepID] = fmt.Errorf(stepErr.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed loading actions; %w", err)
+	}
+
+	return inmemory.NewStateInstance(*w, id, metadata, evt, events, actions, errors), nil
+}
+
+func (m *mgr) Reported(ctx context.Context, i state.Identifier, groupID string, stepID string, size int) (bool, error) {
+	runID := i.RunID.String()
+	complete := false
+
+	err := m.tx(ctx, func(tx *sql.Tx) error {
+		// Lock the run so that concurrent reports within the group are
+		// serialized, and the report adding the final step sees the group as
+		// complete.  Dialects without row locks serialize every write
+		// transaction.
+		if m.dialect.ForUpdate != "" {
+			var status int
+			if err := tx.QueryRowContext(ctx, m.sqlLockRun, runID).Scan(&status); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, sqlInsertReportedStep, runID, groupID, stepID); err != nil {
+			return err
+		}
+		var count int
+		if err := tx.QueryRowContext(ctx, sqlCountReportedSteps, runID, groupID).Scan(&count); err != nil {
+			return err
+		}
+		complete = count == size
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("error recording reported step: %w", err)
+	}
+	return complete, nil
+}
+
+// RecordEvent records the event within the idempotency keys table, using a
+// prefix which can't collide with run idempotency keys.
+func (m *mgr) RecordEvent(ctx context.Context, eventID string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	res, err := m.db.ExecContext(ctx, sqlInsertIdempotencyKey, receivedEventKey(eventID), m.dialect.Time(now.Add(ttl)), m.dialect.Time(now))
+	if err != nil {
+		return false, fmt.Errorf("error recording event: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error recording event: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (m *mgr) ForgetEvent(ctx context.Context, eventID string) error {
+	if _, err := m.db.ExecContext(ctx, sqlDeleteIdempotencyKey, receivedEventKey(eventID)); err != nil {
+		return fmt.Errorf("error forgetting event: %w", err)
+	}
+	return nil
+}
+
+func receivedEventKey(eventID string) string {
+	return "event:" + eventID
+}
+
+func (m *mgr) SaveResponse(ctx context.Context, i state.Identifier, r state.DriverResponse, attempt int) (state.State, error) {
+	var (
+		data    string
+		typ     enums.HistoryType
+		logData any
+	)
+
+	now := time.Now()
+
+	if r.Err == nil {
+		typ = enums.HistoryTypeStepCompleted
+		byt, err := json.Marshal(r.Output)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling step output: %w", err)
+		}
+		data = string(byt)
+		logData = r.Output
+	} else {
+		typ = enums.HistoryTypeStepErrored
+		if r.TimedOut() {
+			typ = enums.HistoryTypeStepTimedOut
+		}
+		if r.Final() {
+			typ = enums.HistoryTypeStepFailed
+		}
+		data = r.Err.Error()
+		logData = data
+	}
+
+	stepHistory := state.History{
+		Type:       typ,
+		Identifier: i,
+		CreatedAt:  now,
+		Data: state.HistoryStep{
+			ID:      r.Step.ID,
+			Name:    r.Step.Name,
+			Attempt: attempt,
+			Data:    logData,
+		},
+	}
+
+	runID := i.RunID.String()
+	failed := r.Err != nil && r.Final()
+
+	err := m.tx(ctx, func(tx *sql.Tx) error {
+		query := sqlSaveStepOutput
+		if r.Err != nil {
+			query = sqlSaveStepError
+		}
+		if _, err := tx.ExecContext(ctx, query, runID, r.Step.ID, data); err != nil {
+			return err
+		}
+		if err := m.insertHistory(ctx, tx, stepHistory); err != nil {
+			return err
+		}
+
+		if !failed {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, sqlFailRun, runID, int(enums.RunStatusFailed)); err != nil {
+			return err
+		}
+		return m.insertHistory(ctx, tx, state.History{
+			Type:       enums.HistoryTypeFunctionFailed,
+			Identifier: i,
+			CreatedAt:  now,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error saving response: %w", err)
+	}
+
+	if failed {
+		// Trigger error callbacks
+		m.callbacks.Run(i, enums.RunStatusFailed)
+	}
+
+	return m.Load(ctx, i.RunID)
+}
+
+func (m *mgr) Started(ctx context.Context, id state.Identifier, stepID string, attempt int) error {
+	return m.insertHistory(ctx, m.db, state.History{
+		Type:       enums.HistoryTypeStepStarted,
+		Identifier: id,
+		CreatedAt:  time.Now(),
+		Data: state.HistoryStep{
+			ID:      stepID,
+			Attempt: attempt,
+		},
+	})
+}
+
+func (m *mgr) Scheduled(ctx context.Context, i state.Identifier, stepID string, attempt int, at *time.Time) error {
+	err := m.tx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, sqlIncrPending, i.RunID.String()); err != nil {
+			return err
+		}
+		return m.insertHistory(ctx, tx, state.History{
+			Type:       enums.HistoryTypeStepScheduled,
+			Identifier: i,
+			CreatedAt:  time.Now(),
+			Data: state.HistoryStep{
+				ID:      stepID,
+				Attempt: attempt,
+				Data:    at,
+			},
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error updating scheduled state: %w", err)
+	}
+	return nil
+}
+
+func (m *mgr) Finalized(ctx context.Context, i state.Identifier, stepID string, attempt int, withStatus ...enums.RunStatus) error {
+	ended := false
+	runID := i.RunID.String()
+
+	err := m.tx(ctx, func(tx *sql.Tx) error {
+		var pending, status int
+		if err := tx.QueryRowContext(ctx, sqlDecrPending, runID).Scan(&pending, &status); err != nil {
+			return err
+		}
+		if pending != 0 {
+			return nil
+		}
+
+		// The run has no outstanding steps, so remove all pauses such as
+		// cancellation pauses.
+		if _, err := tx.ExecContext(ctx, sqlDeleteRunPauses, runID); err != nil {
+			return err
+		}
+
+		final := enums.RunStatusCompleted
+		if len(withStatus) >= 1 {
+			// We're forcing a status, eg. if Finalized was called with an
+			// error to mark the step as Failed.
+			final = withStatus[0]
+		} else if enums.RunStatus(status) != enums.RunStatusRunning {
+			// Only transition to complete if the function hasn't been
+			// cancelled or marked as failed.
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, sqlSetRunStatus, runID, int(final)); err != nil {
+			return err
+		}
+		ended = true
+		return m.insertHistory(ctx, tx, state.History{
+			Type:       enums.HistoryTypeFunctionCompleted,
+			Identifier: i,
+			CreatedAt:  time.Now(),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error finalizing: %w", err)
+	}
+	if ended {
+		m.callbacks.Run(i, enums.RunStatusCompleted)
+	}
+	return nil
+}
+
+func (m *mgr) SavePause(ctx context.Context, p state.Pause) error {
+	packed, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.db.ExecContext(
+		ctx,
+		sqlInsertPause,
+		p.ID,
+		p.WorkspaceID,
+		p.Identifier.RunID.String(),
+		p.Incoming,
+		p.Event,
+		string(packed),
+		m.dialect.Time(p.Expires.Time()),
+		m.dialect.Time(time.Now()),
+		p.MatchKey,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving pause: %w", err)
+	}
+	return nil
+}
+
+func (m *mgr) LeasePause(ctx context.Context, id uuid.UUID) error {
+	now := time.Now()
+
+	res, err := m.db.ExecContext(
+		ctx,
+		sqlLeasePause,
+		id,
+		m.dialect.Time(now.Add(state.PauseLeaseDuration)),
+		m.dialect.Time(now.Add(-pauseExpiryGrace)),
+		m.dialect.Time(now),
+	)
+	if err != nil {
+		return fmt.Errorf("error leasing pause: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 1 {
+		return nil
+	}
+
+	// Either the pause doesn't exist or it's already leased.
+	if _, err := m.PauseByID(ctx, id); err != nil {
+		return err
+	}
+	return state.ErrPauseLeased
+}
+
+func (m *mgr) ConsumePause(ctx context.Context, id uuid.UUID, data any) error {
+	marshalledData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("cannot marshal data to store in state: %w", err)
+	}
+
+	now := time.Now()
+
+	err = m.tx(ctx, func(tx *sql.Tx) error {
+		var packed string
+		if err := tx.QueryRowContext(ctx, sqlConsumePause, id, m.dialect.Time(now.Add(-pauseExpiryGrace)), m.dialect.Time(now)).Scan(&packed); err != nil {
+			return err
+		}
+
+		p := &state.Pause{}
+		if err := json.Unmarshal([]byte(packed), p); err != nil {
+			return err
+		}
+		if p.DataKey == "" {
+			return nil
+		}
+
+		_, err := tx.ExecContext(ctx, sqlSaveStepOutput, p.Identifier.RunID.String(), p.DataKey, string(marshalledData))
+		return err
+	})
+	if err == sql.ErrNoRows {
+		return state.ErrPauseNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("error consuming pause: %w", err)
+	}
+	return nil
+}
+
+// PausesByEvent returns all pauses for a given event within a workspace.
+func (m *mgr) PausesByEvent(ctx context.Context, workspaceID uuid.UUID, event string) (state.PauseIterator, error) {
+	return &iter{
+		db:          m.db,
+		dialect:     m.dialect,
+		workspaceID: workspaceID,
+		event:       event,
+		queries:     []string{sqlPausesByEvent},
+	}, nil
+}
+
+// PausesByEventMatch returns all pauses for a given event within a workspace
+// which have no match key or have one of the given match keys.
+func (m *mgr) PausesByEventMatch(ctx context.Context, workspaceID uuid.UUID, event string, keys []string) (state.PauseIterator, error) {
+	queries := []string{sqlPausesByEventUnmatched}
+	if len(keys) > 0 {
+		queries = append(queries, m.sqlPausesByEventMatch)
+	}
+	arr, err := m.dialect.Array(keys)
+	if err != nil {
+		return nil, err
+	}
+	return &iter{
+		db:          m.db,
+		dialect:     m.dialect,
+		workspaceID: workspaceID,
+		event:       event,
+		queries:     queries,
+		match:       m.sqlPausesByEventMatch,
+		keys:        arr,
+	}, nil
+}
+
+func (m *mgr) PauseByID(ctx context.Context, id uuid.UUID) (*state.Pause, error) {
+	now := time.Now()
+	return m.pause(ctx, sqlPauseByID, id, m.dialect.Time(now.Add(-pauseExpiryGrace)), m.dialect.Time(now))
+}
+
+// PauseByStep returns a specific pause for a given workflow run, from a given step.
+//
+// This is required when continuing a step function from an async step, ie. one that
+// has deferred results which must be continued by resuming the specific pause set
+// up for the given step ID.
+func (m *mgr) PauseByStep(ctx context.Context, i state.Identifier, actionID string) (*state.Pause, error) {
+	return m.pause(ctx, sqlPauseByStep, i.RunID.String(), actionID, m.dialect.Time(time.Now()))
+}
+
+func (m *mgr) pause(ctx context.Context, query string, args ...any) (*state.Pause, error) {
+	var packed string
+	err := m.db.QueryRowContext(ctx, query, args...).Scan(&packed)
+	if err == sql.ErrNoRows {
+		return nil, state.ErrPauseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	pause := &state.Pause{}
+	err = json.Unmarshal([]byte(packed), pause)
+	return pause, err
+}
+
+func (m *mgr) SaveDebounce(ctx context.Context, d state.Debounce) error {
+	byt, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("error marshalling debounce: %w", err)
+	}
+	if _, err := m.db.ExecContext(ctx, sqlSaveDebounce, d.Key, d.ID.String(), string(byt)); err != nil {
+		return fmt.Errorf("error saving debounce: %w", err)
+	}
+	return nil
+}
+
+func (m *mgr) RestoreDebounce(ctx context.Context, d state.Debounce) error {
+	byt, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("error marshalling debounce: %w", err)
+	}
+	if _, err := m.db.ExecContext(ctx, sqlRestoreDebounce, d.Key, d.ID.String(), string(byt)); err != nil {
+		return fmt.Errorf("error restoring debounce: %w", err)
+	}
+	return nil
+}
+
+func (m *mgr) ConsumeDebounce(ctx context.Context, key string, id ulid.ULID) (*state.Debounce, error) {
+	var byt string
+	err := m.db.QueryRowContext(ctx, sqlConsumeDebounce, key, id.String()).Scan(&byt)
+	if err == sql.ErrNoRows {
+		return nil, state.ErrDebounceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error consuming debounce: %w", err)
+	}
+	d := &state.Debounce{}
+	if err := json.Unmarshal([]byte(byt), d); err != nil {
+		return nil, fmt.Errorf("error unmarshalling debounce: %w", err)
+	}
+	return d, nil
+}
+
+func (m *mgr) History(ctx context.Context, runID ulid.ULID) ([]state.History, error) {
+	rows, err := m.db.QueryContext(ctx, sqlLoadHistory, runID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []state.History{}
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		var h state.History
+		if err := json.Unmarshal([]byte(data), &h); err != nil {
+			return nil, err
+		}
+		history = append(history, h)
+	}
+	return history, rows.Err()
+}
+
+// tx runs the given function within a transaction, committing the transaction
+// if the function succeeds.
+func (m *mgr) tx(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func (m *mgr) insertHistory(ctx context.Context, db execer, h state.History) error {
+	byt, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, sqlInsertHistory, h.Identifier.RunID.String(), m.dialect.Time(h.CreatedAt), string(byt))
+	return err
+}
+
+// nullJSON returns the JSON-encoded value if ok is true, or nil to store NULL.
+func nullJSON(ok bool, v any) (*string, error) {
+	if !ok {
+		return nil, nil
+	}
+	byt, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	str := string(byt)
+	return &str, nil
+}
+
+// iter iterates over pauses for an event, loading pauses in pages ordered by
+// pause ID.  Each query is iterated in turn.
+type iter struct {
+	db          *sql.DB
+	dialect     sqldialect.Dialect
+	workspaceID uuid.UUID
+	event       string
+	queries     []string
+	// match is the query which loads pauses with one of the given keys,
+	// passed as an array.
+	match string
+	keys  any
+
+	// cursor is the ID of the last pause loaded, or uuid.Nil if no pauses
+	// have been loaded.
+	cursor uuid.UUID
+	page   []*state.Pause
+	done   bool
+	val    *state.Pause
+}
+
+func (i *iter) Next(ctx context.Context) bool {
+	for len(i.page) == 0 {
+		if i.done {
+			if len(i.queries) <= 1 {
+				i.val = nil
+				return false
+			}
+			// Move on to the next query from the start.
+			i.queries, i.cursor, i.done = i.queries[1:], uuid.Nil, false
+		}
+		if err := i.load(ctx); err != nil {
+			i.queries, i.done = nil, true
+		}
+	}
+	i.val, i.page = i.page[0], i.page[1:]
+	return true
+}
+
+func (i *iter) Val(ctx context.Context) *state.Pause {
+	return i.val
+}
+
+func (i *iter) load(ctx context.Context) error {
+	now := time.Now()
+	args := []any{
+		i.workspaceID,
+		i.event,
+		i.dialect.Time(now.Add(-pauseExpiryGrace)),
+		i.dialect.Time(now),
+		i.cursor,
+		pausePageSize,
+	}
+	if i.queries[0] == i.match {
+		args = append(args, i.keys)
+	}
+	rows, err := i.db.QueryContext(ctx, i.queries[0], args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		var (
+			id     uuid.UUID
+			packed string
+		)
+		if err := rows.Scan(&id, &packed); err != nil {
+			return err
+		}
+		n++
+		i.cursor = id
+
+		pause := &state.Pause{}
+		if err := json.Unmarshal([]byte(packed), pause); err != nil {
+			continue
+		}
+		i.page = append(i.page, pause)
+	}
+	if n < pausePageSize {
+		i.done = true
+	}
+	return rows.Err()
+}
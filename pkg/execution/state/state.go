@@ -3,6 +3,7 @@ package state
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +13,10 @@ import (
 )
 
 const (
+	// ContextKeyInvokePauseID is the Metadata.Context key storing the ID of the
+	// pause which awaits the result of an invoked function run.
+	ContextKeyInvokePauseID = "invoke_pause_id"
+
 	// PauseLeaseDuration is the lifetime that a pause's lease is valid for.
 	PauseLeaseDuration = 5 * time.Second
 )
@@ -30,6 +35,12 @@ var (
 	ErrFunctionCancelled = fmt.Errorf("function cancelled")
 	ErrFunctionComplete  = fmt.Errorf("function completed")
 	ErrFunctionFailed    = fmt.Errorf("function failed")
+	// ErrDebounceNotFound is returned when consuming a debounce that doesn't
+	// exist, or that has been replaced by a newer event.
+	ErrDebounceNotFound = fmt.Errorf("debounce not found")
+	// ErrStepTimedOut is used as a step's response error when the step is
+	// cancelled after exceeding its max duration.
+	ErrStepTimedOut = fmt.Errorf("step timed out")
 )
 
 // Identifier represents the unique identifier for a workflow run.
@@ -40,6 +51,19 @@ type Identifier struct {
 	// Key represents a unique idempotency key used to deduplicate this
 	// workflow run amongst other runs for the same workflow.
 	Key string `json:"key"`
+	// Concurrency stores the evaluated concurrency limit for the run, if the
+	// workflow specifies one.  This is used by queues to limit the number of
+	// steps processed at the same time.
+	Concurrency *Concurrency `json:"c,omitempty"`
+}
+
+// Concurrency represents an evaluated concurrency limit for a workflow run.
+type Concurrency struct {
+	// Key is the concurrency key, unique to each workflow and evaluated key
+	// expression.  Runs with the same key share the same limit.
+	Key string `json:"k"`
+	// Limit is the maximum number of steps which can run concurrently.
+	Limit int `json:"l"`
 }
 
 // IdempotencyKey returns the unique key used to represent this single
@@ -101,6 +125,24 @@ type Metadata struct {
 
 	// Context allows storing any other contextual data in metadata.
 	Context map[string]any `json:"ctx,omitempty"`
+
+	// EventIDs stores the IDs of every event which triggered the run.  For
+	// batched runs this contains more than one ID.
+	EventIDs []string `json:"eventIDs,omitempty"`
+}
+
+// InvokePauseID returns the ID of the pause awaiting this run's result, if the
+// run was started by another function via enums.OpcodeInvokeFunction.
+func (m Metadata) InvokePauseID() (uuid.UUID, bool) {
+	str, ok := m.Context[ContextKeyInvokePauseID].(string)
+	if !ok {
+		return uuid.UUID{}, false
+	}
+	id, err := uuid.Parse(str)
+	if err != nil {
+		return uuid.UUID{}, false
+	}
+	return id, true
 }
 
 // State represents the current state of a fn run.  It is data-structure
@@ -131,6 +173,11 @@ type State interface {
 	// an Inngest event.
 	Event() map[string]interface{}
 
+	// Events returns every event which triggered the run.  Runs started by a
+	// batch trigger hold more than one event;  for all other runs this contains
+	// only the root event.
+	Events() []map[string]interface{}
+
 	// Actions returns a map of all output from each individual action.
 	Actions() map[string]any
 
@@ -152,6 +199,7 @@ type Manager interface {
 	Loader
 	Mutater
 	PauseManager
+	DebounceManager
 }
 
 // FunctionNotifier is an optional interface that state stores can fulfil,
@@ -174,6 +222,64 @@ type FunctionNotifier interface {
 
 type FunctionCallback func(context.Context, Identifier, enums.RunStatus)
 
+// FunctionCallbacks stores the callbacks added to a FunctionNotifier.  Callbacks
+// may be added while runs change status, so this is safe for concurrent use.
+type FunctionCallbacks struct {
+	lock      sync.RWMutex
+	callbacks []FunctionCallback
+}
+
+// Add adds the given callbacks.
+func (c *FunctionCallbacks) Add(f ...FunctionCallback) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.callbacks = append(c.callbacks, f...)
+}
+
+// Run invokes each callback in the background with the given run's status.
+func (c *FunctionCallbacks) Run(id Identifier, status enums.RunStatus) {
+	c.lock.RLock()
+	callbacks := c.callbacks
+	c.lock.RUnlock()
+
+	// Give all callbacks 5 seconds to run in total.
+	callCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	wg := sync.WaitGroup{}
+	for _, f := range callbacks {
+		wg.Add(1)
+		go func(fn FunctionCallback) {
+			defer wg.Done()
+			fn(callCtx, id, status)
+		}(f)
+	}
+	// Cancel the context once every callback has returned, rather than on
+	// return of this function.
+	go func() {
+		wg.Wait()
+		cancel()
+	}()
+}
+
+// RunLister lists the runs of a function.  This is optionally implemented by
+// state stores which index runs by function.
+type RunLister interface {
+	// RunningRuns returns the identifiers of a function's running runs which
+	// started within the given time range, inclusive of from and exclusive of to.
+	RunningRuns(ctx context.Context, workflowID uuid.UUID, from, to time.Time) ([]Identifier, error)
+}
+
+// EventRecorder records the IDs of received events, allowing duplicate events to
+// be dropped.  This is optionally implemented by state stores.
+type EventRecorder interface {
+	// RecordEvent records that the event with the given ID was received,
+	// returning false if the event was already recorded within the TTL.
+	RecordEvent(ctx context.Context, eventID string, ttl time.Duration) (bool, error)
+
+	// ForgetEvent removes the record of the given event, such that the event
+	// is processed if it's received again.
+	ForgetEvent(ctx context.Context, eventID string) error
+}
+
 // Loader allows loading of previously stored state based off of a given Identifier.
 type Loader interface {
 	// Load returns run state for the given identifier.
@@ -200,8 +306,9 @@ type Mutater interface {
 	New(ctx context.Context, input Input) (State, error)
 
 	// Cancel sets a function run metadata status to RunStatusCancelled, which prevents
-	// future execution of steps.
-	Cancel(ctx context.Context, i Identifier) error
+	// future execution of steps.  The cancellation is recorded within the run's
+	// history as a HistoryTypeFunctionCancelled entry.
+	Cancel(ctx context.Context, i Identifier, c HistoryFunctionCancelled) error
 
 	// scheduled increases the scheduled count for a run's metadata.
 	//
@@ -230,6 +337,20 @@ type Mutater interface {
 	// Attempt is zero-indexed.
 	Finalized(ctx context.Context, i Identifier, stepID string, attempt int, status ...enums.RunStatus) error
 
+	// Reported records that a step within the given group has reported, eg. that a
+	// generator step planned in parallel with other steps has finished, or that a
+	// step within a join has finished or been skipped.  The group contains size steps.
+	//
+	// This returns true if every step within the group has reported, including
+	// when the same step reports more than once, eg. when a queue item is retried
+	// after the group completed.  Callers must therefore act on completion
+	// idempotently, eg. by enqueueing the next step with a deterministic job ID,
+	// such that a retry after a partial failure still continues the run.
+	//
+	// This must be atomic:  when steps within a group report concurrently, the
+	// report which adds the group's final step must see the group as complete.
+	Reported(ctx context.Context, i Identifier, groupID string, stepID string, size int) (bool, error)
+
 	// SaveResponse saves the driver response for the attempt to the backing state store.
 	//
 	// If the response is an error, this must store the error for the specific attempt, allowing
@@ -251,6 +372,13 @@ type Input struct {
 	// original event data.
 	EventData map[string]any
 
+	// Events is the batch of events which triggered a batched run, including
+	// EventData.  This is empty for runs triggered by a single event.
+	Events []map[string]any
+
+	// EventIDs records the IDs of every event which triggered the run.
+	EventIDs []string
+
 	// Debugger represents whether this function was started via the debugger.
 	Debugger bool
 
@@ -268,4 +396,13 @@ type Input struct {
 
 	// Context is additional context for the run stored in metadata.
 	Context map[string]any
+
+	// IdempotencyTTL is the duration for which the identifier's idempotency key
+	// is held.  If zero, the key is held for as long as the run's state.
+	IdempotencyTTL time.Duration
+
+	// Skipped records the run as skipped:  the run is stored with RunStatusSkipped
+	// for visibility in history, without claiming the identifier's idempotency key.
+	// Skipped runs must never be scheduled.
+	Skipped bool
 }
@@ -108,6 +108,20 @@ func (mr *MockStateMockRecorder) Event() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Event", reflect.TypeOf((*MockState)(nil).Event))
 }
 
+// Events mocks base method.
+func (m *MockState) Events() []map[string]interface{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].([]map[string]interface{})
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockStateMockRecorder) Events() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockState)(nil).Events))
+}
+
 // Identifier mocks base method.
 func (m *MockState) Identifier() Identifier {
 	m.ctrl.T.Helper()
@@ -103,6 +103,7 @@ func CheckState(t *testing.T, gen Generator) {
 	funcs := map[string]func(t *testing.T, m state.Manager){
 		"New":                                checkNew,
 		"New/StepData":                       checkNew_stepdata,
+		"New/Batch":                          checkNew_batch,
 		"Scheduled":                          checkScheduled,
 		"SaveResponse/Output":                checkSaveResponse_output,
 		"SaveResponse/Error":                 checkSaveResponse_error,
@@ -119,13 +120,23 @@ func CheckState(t *testing.T, gen Generator) {
 		"PausesByEvent/Multiple":             checkPausesByEvent_multi,
 		"PausesByEvent/ConcurrentCursors":    checkPausesByEvent_concurrent,
 		"PausesByEvent/Consumed":             checkPausesByEvent_consumed,
+		"PausesByEventMatch":                 checkPausesByEventMatch,
+		"PausesByEventMatch/Consumed":        checkPausesByEventMatch_consumed,
 		"PauseByStep":                        checkPausesByStep,
 		"PauseByID":                          checkPauseByID,
 		"Idempotency":                        checkIdempotency,
+		"Idempotency/Skipped":                checkIdempotency_skipped,
+		"Debounce":                           checkDebounce,
 		"Cancel":                             checkCancel,
 		"Cancel/AlreadyCompleted":            checkCancel_completed,
 		"Cancel/AlreadyCancelled":            checkCancel_cancelled,
+		"Cancel/DeletesPauses":               checkCancelDeletesPauses,
+		"RunningRuns":                        checkRunningRuns,
+		"RecordEvent":                        checkRecordEvent,
 		"Finalized/Status":                   checkFinalizedStatus,
+		"Reported":                           checkReported,
+		"Reported/Concurrent":                checkReported_concurrent,
+		"Finalized/DeletesPauses":            checkFinalizedDeletesPauses,
 		"Log/FunctionLog":                    checkLogs,
 	}
 	for name, f := range funcs {
@@ -179,6 +190,62 @@ func checkNew(t *testing.T, m state.Manager) {
 	require.Equal(t, 1, metadata.Pending, "New should set pending count to 1")
 }
 
+// checkNew_batch ensures that state stores record every event for runs started
+// by a batch of events.
+func checkNew_batch(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+	w.UUID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(w.ID))
+	runID := ulid.MustNew(ulid.Now(), rand.Reader)
+	id := state.Identifier{
+		WorkflowID: w.UUID,
+		RunID:      runID,
+		Key:        runID.String(),
+	}
+
+	first, second := input, input
+	first.ID = "evt-1"
+	second.ID = "evt-2"
+	events := []map[string]any{first.Map(), second.Map()}
+
+	s, err := m.New(ctx, state.Input{
+		Identifier: id,
+		Workflow:   w,
+		EventData:  first.Map(),
+		Events:     events,
+		EventIDs:   []string{"evt-1", "evt-2"},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, first.Map(), s.Event(), "Returned event does not match input")
+	require.EqualValues(t, events, s.Events(), "Returned events do not match input")
+	require.EqualValues(t, []string{"evt-1", "evt-2"}, s.Metadata().EventIDs)
+
+	loaded, err := m.Load(ctx, s.RunID())
+	require.NoError(t, err)
+	require.EqualValues(t, first.Map(), loaded.Event(), "Loaded event does not match input")
+	require.EqualValues(t, events, loaded.Events(), "Loaded events do not match input")
+	require.EqualValues(t, []string{"evt-1", "evt-2"}, loaded.Metadata().EventIDs)
+
+	t.Run("Runs without a batch return the root event", func(t *testing.T) {
+		runID := ulid.MustNew(ulid.Now(), rand.Reader)
+		id := state.Identifier{
+			WorkflowID: w.UUID,
+			RunID:      runID,
+			Key:        runID.String(),
+		}
+		_, err := m.New(ctx, state.Input{
+			Identifier: id,
+			Workflow:   w,
+			EventData:  first.Map(),
+		})
+		require.NoError(t, err)
+
+		loaded, err := m.Load(ctx, runID)
+		require.NoError(t, err)
+		require.EqualValues(t, []map[string]any{first.Map()}, loaded.Events())
+		require.Empty(t, loaded.Metadata().EventIDs)
+	})
+}
+
 // checkNew_stepdata ensures that state stores can be initialized with
 // predetermined step data.
 func checkNew_stepdata(t *testing.T, m state.Manager) {
@@ -472,6 +539,66 @@ func checkSaveResponse_concurrent(t *testing.T, m state.Manager) {
 	require.EqualValues(t, 1, loaded.Metadata().Pending, "scheduling and finalizing concurrently should end with 1 (the trigger)")
 }
 
+func checkReported(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+	s := setup(t, m)
+	id := s.Identifier()
+
+	complete, err := m.Reported(ctx, id, "group", "a", 2)
+	require.NoError(t, err)
+	require.False(t, complete)
+
+	// Reporting the same step again is a no-op.
+	complete, err = m.Reported(ctx, id, "group", "a", 2)
+	require.NoError(t, err)
+	require.False(t, complete)
+
+	complete, err = m.Reported(ctx, id, "group", "b", 2)
+	require.NoError(t, err)
+	require.True(t, complete)
+
+	// Reporting a step after the group completes, eg. when retrying the step,
+	// still sees the group as complete.
+	complete, err = m.Reported(ctx, id, "group", "b", 2)
+	require.NoError(t, err)
+	require.True(t, complete, "retried reports must see the group as complete")
+
+	// Groups are independent.
+	complete, err = m.Reported(ctx, id, "another", "c", 1)
+	require.NoError(t, err)
+	require.True(t, complete)
+}
+
+func checkReported_concurrent(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+	s := setup(t, m)
+	id := s.Identifier()
+
+	n := 20
+	var complete int32
+	wg := &sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		step := fmt.Sprintf("step-%d", i%(n/2))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each step reports twice.
+			ok, err := m.Reported(ctx, id, "group", step, n/2)
+			require.NoError(t, err)
+			if ok {
+				atomic.AddInt32(&complete, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.GreaterOrEqual(t, atomic.LoadInt32(&complete), int32(1), "the final report must complete the group")
+
+	ok, err := m.Reported(ctx, id, "group", "step-0", n/2)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
 func checkSavePause(t *testing.T, m state.Manager) {
 	ctx := context.Background()
 	s := setup(t, m)
@@ -1007,6 +1134,119 @@ func checkPausesByEvent_concurrent(t *testing.T, m state.Manager) {
 	}
 }
 
+func checkPausesByEventMatch(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+	s := setup(t, m)
+
+	evtA := "event/a-match"
+	keyA := "data.id=\"a\""
+	keyB := "data.id=\"b\""
+
+	// Save a pause without a match key and a pause for each match key.
+	pauses := map[string]state.Pause{}
+	for name, key := range map[string]*string{"none": nil, "a": &keyA, "b": &keyB} {
+		p := state.Pause{
+			ID:         uuid.New(),
+			Identifier: s.Identifier(),
+			Outgoing:   inngest.TriggerName,
+			Incoming:   w.Steps[0].ID,
+			Expires:    state.Time(time.Now().Add(time.Minute).Truncate(time.Millisecond).UTC()),
+			Event:      &evtA,
+			MatchKey:   key,
+		}
+		err := m.SavePause(ctx, p)
+		require.NoError(t, err)
+		pauses[name] = p
+	}
+
+	// Pauses without a match key are always returned, alongside pauses with
+	// a matching key.
+	iter, err := m.PausesByEventMatch(ctx, uuid.UUID{}, evtA, []string{keyA, "data.id=\"c\""})
+	require.NoError(t, err)
+	seen := pauseIDs(ctx, iter)
+	require.Contains(t, seen, pauses["none"].ID)
+	require.Contains(t, seen, pauses["a"].ID)
+	require.NotContains(t, seen, pauses["b"].ID)
+
+	iter, err = m.PausesByEventMatch(ctx, uuid.UUID{}, evtA, nil)
+	require.NoError(t, err)
+	seen = pauseIDs(ctx, iter)
+	require.Contains(t, seen, pauses["none"].ID)
+	require.NotContains(t, seen, pauses["a"].ID)
+	require.NotContains(t, seen, pauses["b"].ID)
+
+	// The match key must be stored with the pause.
+	found, err := m.PauseByID(ctx, pauses["a"].ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.MatchKey)
+	require.Equal(t, keyA, *found.MatchKey)
+
+	// PausesByEvent returns all pauses, regardless of match keys.
+	iter, err = m.PausesByEvent(ctx, uuid.UUID{}, evtA)
+	require.NoError(t, err)
+	seen = pauseIDs(ctx, iter)
+	for _, p := range pauses {
+		require.Contains(t, seen, p.ID)
+	}
+}
+
+func checkPausesByEventMatch_consumed(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+	s := setup(t, m)
+
+	evtA := "event/a-match-consumed"
+	key := "data.id=\"a\""
+
+	pauses := []state.Pause{}
+	for i := 0; i < 2; i++ {
+		p := state.Pause{
+			ID:         uuid.New(),
+			Identifier: s.Identifier(),
+			Outgoing:   inngest.TriggerName,
+			Incoming:   w.Steps[0].ID,
+			Expires:    state.Time(time.Now().Add(time.Duration(i+1) * time.Minute).Truncate(time.Millisecond).UTC()),
+			Event:      &evtA,
+			MatchKey:   &key,
+		}
+		err := m.SavePause(ctx, p)
+		require.NoError(t, err)
+		pauses = append(pauses, p)
+	}
+
+	err := m.ConsumePause(ctx, pauses[0].ID, nil)
+	require.NoError(t, err)
+
+	iter, err := m.PausesByEventMatch(ctx, uuid.UUID{}, evtA, []string{key})
+	require.NoError(t, err)
+	seen := pauseIDs(ctx, iter)
+	require.NotContains(t, seen, pauses[0].ID, "returned a consumed pause within iterator")
+	require.Contains(t, seen, pauses[1].ID)
+
+	// Consuming every pause for a match key must remove them from all
+	// iterators.
+	err = m.ConsumePause(ctx, pauses[1].ID, nil)
+	require.NoError(t, err)
+
+	iter, err = m.PausesByEventMatch(ctx, uuid.UUID{}, evtA, []string{key})
+	require.NoError(t, err)
+	require.Empty(t, pauseIDs(ctx, iter))
+
+	iter, err = m.PausesByEvent(ctx, uuid.UUID{}, evtA)
+	require.NoError(t, err)
+	require.Empty(t, pauseIDs(ctx, iter))
+}
+
+// pauseIDs returns the IDs of all pauses returned from the given iterator.
+func pauseIDs(ctx context.Context, iter state.PauseIterator) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for iter.Next(ctx) {
+		if p := iter.Val(ctx); p != nil {
+			ids = append(ids, p.ID)
+		}
+	}
+	return ids
+}
+
 func checkPausesByEvent_consumed(t *testing.T, m state.Manager) {
 	ctx := context.Background()
 	s := setup(t, m)
@@ -1289,6 +1529,120 @@ func checkIdempotency(t *testing.T, m state.Manager) {
 	assert.Equal(t, int32(99), atomic.LoadInt32(&errCount), "Must have errored 99 times when the run ID exists")
 }
 
+func checkIdempotency_skipped(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+
+	w.UUID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(w.ID))
+	id := state.Identifier{
+		WorkflowID: w.UUID,
+		RunID:      ulid.MustNew(ulid.Now(), rand.Reader),
+		Key:        "order-123",
+	}
+	data := input.Map()
+
+	_, err := m.New(ctx, state.Input{
+		Identifier:     id,
+		Workflow:       w,
+		EventData:      data,
+		IdempotencyTTL: time.Hour,
+	})
+	require.NoError(t, err)
+
+	// A new run with the same key must be rejected.
+	dupe := id
+	dupe.RunID = ulid.MustNew(ulid.Now(), rand.Reader)
+	_, err = m.New(ctx, state.Input{
+		Identifier: dupe,
+		Workflow:   w,
+		EventData:  data,
+	})
+	require.ErrorIs(t, err, state.ErrIdentifierExists)
+
+	// Recording the duplicate as skipped succeeds, and doesn't start the run.
+	s, err := m.New(ctx, state.Input{
+		Identifier: dupe,
+		Workflow:   w,
+		EventData:  data,
+		Skipped:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, enums.RunStatusSkipped, s.Metadata().Status)
+	require.Equal(t, 0, s.Metadata().Pending)
+
+	loaded, err := m.Load(ctx, dupe.RunID)
+	require.NoError(t, err)
+	require.Equal(t, enums.RunStatusSkipped, loaded.Metadata().Status)
+	require.Equal(t, dupe, loaded.Identifier())
+
+	history, err := m.History(ctx, dupe.RunID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	require.Equal(t, enums.HistoryTypeFunctionSkipped, history[0].Type)
+	skipped, ok := history[0].Data.(state.HistoryFunctionSkipped)
+	require.True(t, ok, "history data must be HistoryFunctionSkipped, got %T", history[0].Data)
+	require.Equal(t, "order-123", skipped.Key)
+
+	// The original run is unaffected.
+	orig, err := m.Load(ctx, id.RunID)
+	require.NoError(t, err)
+	require.Equal(t, enums.RunStatusRunning, orig.Metadata().Status)
+}
+
+func checkDebounce(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+
+	first := state.Debounce{
+		ID:         ulid.MustNew(ulid.Now(), rand.Reader),
+		Key:        "fn-debounce-key",
+		FunctionID: w.ID,
+		Event:      input,
+		Period:     10 * time.Second,
+	}
+	err := m.SaveDebounce(ctx, first)
+	require.NoError(t, err)
+
+	// Replace the debounce with a newer event.
+	latest := first
+	latest.ID = ulid.MustNew(ulid.Now(), rand.Reader)
+	latest.Event.ID = "latest"
+	err = m.SaveDebounce(ctx, latest)
+	require.NoError(t, err)
+
+	// The replaced debounce can't be consumed.
+	_, err = m.ConsumeDebounce(ctx, first.Key, first.ID)
+	require.ErrorIs(t, err, state.ErrDebounceNotFound)
+
+	d, err := m.ConsumeDebounce(ctx, latest.Key, latest.ID)
+	require.NoError(t, err)
+	require.Equal(t, latest.ID, d.ID)
+	require.Equal(t, latest.FunctionID, d.FunctionID)
+	require.Equal(t, "latest", d.Event.ID)
+	require.Equal(t, latest.Event.Name, d.Event.Name)
+
+	// Debounces can only be consumed once.
+	_, err = m.ConsumeDebounce(ctx, latest.Key, latest.ID)
+	require.ErrorIs(t, err, state.ErrDebounceNotFound)
+
+	// Restored debounces can be consumed again.
+	err = m.RestoreDebounce(ctx, *d)
+	require.NoError(t, err)
+	restored, err := m.ConsumeDebounce(ctx, latest.Key, latest.ID)
+	require.NoError(t, err)
+	require.Equal(t, "latest", restored.Event.ID)
+
+	// Restoring a debounce keeps any newer debounce.
+	newer := latest
+	newer.ID = ulid.MustNew(ulid.Now(), rand.Reader)
+	err = m.SaveDebounce(ctx, newer)
+	require.NoError(t, err)
+	err = m.RestoreDebounce(ctx, *d)
+	require.NoError(t, err)
+	_, err = m.ConsumeDebounce(ctx, latest.Key, latest.ID)
+	require.ErrorIs(t, err, state.ErrDebounceNotFound)
+	_, err = m.ConsumeDebounce(ctx, newer.Key, newer.ID)
+	require.NoError(t, err)
+}
+
 func checkCancel(t *testing.T, m state.Manager) {
 	ctx := context.Background()
 	w.UUID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(w.ID))
@@ -1309,12 +1663,20 @@ func checkCancel(t *testing.T, m state.Manager) {
 	require.NoError(t, err)
 	require.EqualValues(t, enums.RunStatusRunning, s.Metadata().Status, "Status is not Running")
 
-	err = m.Cancel(ctx, s.Identifier())
+	err = m.Cancel(ctx, s.Identifier(), state.HistoryFunctionCancelled{Type: enums.CancellationTypeManual})
 	require.NoError(t, err)
 
 	reloaded, err := m.Load(ctx, s.RunID())
 	require.NoError(t, err)
 	require.EqualValues(t, enums.RunStatusCancelled, reloaded.Metadata().Status, "Status is not Cancelled")
+
+	history, err := m.History(ctx, s.RunID())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(history))
+	require.Equal(t, enums.HistoryTypeFunctionCancelled, history[1].Type)
+	cancelled, ok := history[1].Data.(state.HistoryFunctionCancelled)
+	require.True(t, ok, "history data must be HistoryFunctionCancelled, got %T", history[1].Data)
+	require.Equal(t, enums.CancellationTypeManual, cancelled.Type)
 }
 
 func checkCancel_cancelled(t *testing.T, m state.Manager) {
@@ -1336,13 +1698,13 @@ func checkCancel_cancelled(t *testing.T, m state.Manager) {
 	require.NoError(t, err)
 	require.EqualValues(t, enums.RunStatusRunning, s.Metadata().Status, "Status is not Running")
 
-	err = m.Cancel(ctx, s.Identifier())
+	err = m.Cancel(ctx, s.Identifier(), state.HistoryFunctionCancelled{Type: enums.CancellationTypeManual})
 	require.NoError(t, err)
 	reloaded, err := m.Load(ctx, s.RunID())
 	require.NoError(t, err)
 	require.EqualValues(t, enums.RunStatusCancelled, reloaded.Metadata().Status, "Status is not Cancelled")
 
-	err = m.Cancel(ctx, s.Identifier())
+	err = m.Cancel(ctx, s.Identifier(), state.HistoryFunctionCancelled{Type: enums.CancellationTypeManual})
 	require.Equal(t, err, state.ErrFunctionCancelled)
 }
 
@@ -1372,7 +1734,7 @@ func checkCancel_completed(t *testing.T, m state.Manager) {
 	require.NoError(t, err)
 	require.EqualValues(t, enums.RunStatusCompleted, s.Metadata().Status, "Status is not Complete after finalizing")
 
-	err = m.Cancel(ctx, s.Identifier())
+	err = m.Cancel(ctx, s.Identifier(), state.HistoryFunctionCancelled{Type: enums.CancellationTypeManual})
 	require.Equal(t, err, state.ErrFunctionComplete)
 
 	s, err = m.Load(ctx, s.RunID())
@@ -1399,7 +1761,7 @@ func checkFinalizedStatus(t *testing.T, m state.Manager) {
 	t.Run("It doesn't overwrite cancelled statuses, etc", func(t *testing.T) {
 		s = setup(t, m)
 
-		err = m.Cancel(ctx, s.Identifier())
+		err = m.Cancel(ctx, s.Identifier(), state.HistoryFunctionCancelled{Type: enums.CancellationTypeManual})
 		require.NoError(t, err)
 
 		loaded, err := m.Load(ctx, s.RunID())
@@ -1477,6 +1839,30 @@ func checkLogs(t *testing.T, m state.Manager) {
 		require.Equal(t, w.Steps[0].Name, stepdata.Name)
 	})
 
+	t.Run("SaveResponse() with a timed out step stores HistoryTypeStepTimedOut", func(t *testing.T) {
+		s = setup(t, m)
+		<-time.After(time.Millisecond)
+
+		r := state.DriverResponse{
+			Step: w.Steps[0],
+			Err:  fmt.Errorf("%w: context deadline exceeded", state.ErrStepTimedOut),
+		}
+		_, err := m.SaveResponse(ctx, s.Identifier(), r, 1)
+		require.NoError(t, err)
+
+		history, err := m.History(ctx, s.RunID())
+		require.NoError(t, err)
+		require.Equal(t, 2, len(history))
+
+		timedOut := history[1]
+		require.Equal(t, enums.HistoryTypeStepTimedOut.String(), timedOut.Type.String())
+
+		stepdata, ok := timedOut.Data.(state.HistoryStep)
+		require.True(t, ok, "step data is %T instead of state.HistoryStep (%v)", timedOut.Data, timedOut.Data)
+		require.Equal(t, 1, stepdata.Attempt)
+		require.Equal(t, w.Steps[0].ID, stepdata.ID)
+	})
+
 	t.Run("SaveResponse() with a final error stores HistoryTypeStepFailed and HistoryTypeFunctionFailed", func(t *testing.T) {
 		s = setup(t, m)
 		<-time.After(time.Millisecond)
@@ -1590,48 +1976,196 @@ func checkLogs(t *testing.T, m state.Manager) {
 	})
 }
 
-// TODO: Optimization - when finalizing steps, we should delete all pauses when the counter is set to 0
-/*
 func checkFinalizedDeletesPauses(t *testing.T, m state.Manager) {
 	ctx := context.Background()
 	s := setup(t, m)
+	other := setup(t, m)
 
-	// Create a pause.
-	evt := "event/a"
-	pause := state.Pause{
-		ID:         uuid.New(),
-		Identifier: s.Identifier(),
-		Outgoing:   inngest.TriggerName,
-		Incoming:   w.Steps[0].ID,
-		Expires:    state.Time(time.Now().Add(time.Minute)),
-		Event:      &evt,
+	pauses := savePausesForRun(t, m, s, "event/finalized-deletes-pauses")
+	kept := savePausesForRun(t, m, other, "event/finalized-deletes-pauses")
+
+	// Finalize, reducing count to 0 which should delete all active pauses for this identifier
+	err := m.Finalized(ctx, s.Identifier(), inngest.TriggerName, 0)
+	require.NoError(t, err)
+
+	requirePausesDeleted(t, m, "event/finalized-deletes-pauses", pauses, kept)
+}
+
+func checkRecordEvent(t *testing.T, m state.Manager) {
+	recorder, ok := m.(state.EventRecorder)
+	if !ok {
+		t.Skip("state store does not implement state.EventRecorder")
 	}
-	err := m.SavePause(ctx, pause)
+
+	ctx := context.Background()
+	id := ulid.MustNew(ulid.Now(), rand.Reader).String()
+
+	added, err := recorder.RecordEvent(ctx, id, time.Minute)
 	require.NoError(t, err)
+	require.True(t, added)
 
-	iter, err := m.PausesByEvent(ctx, evt)
+	added, err = recorder.RecordEvent(ctx, id, time.Minute)
 	require.NoError(t, err)
-	require.NotNil(t, iter)
-	require.True(t, iter.Next(ctx))
-	require.EqualValues(t, &pause, iter.Val(ctx))
+	require.False(t, added, "duplicate events must not be recorded")
 
-	found, err := m.PauseByID(ctx, pause.ID)
-	require.Nil(t, err)
-	require.EqualValues(t, pause, *found)
+	t.Run("Forgotten events can be recorded again", func(t *testing.T) {
+		err := recorder.ForgetEvent(ctx, id)
+		require.NoError(t, err)
+		added, err := recorder.RecordEvent(ctx, id, time.Minute)
+		require.NoError(t, err)
+		require.True(t, added)
+	})
+}
 
-	// Finalize, reducing count to 0 which should delete all active pauses for this identifier
-	err = m.Finalized(ctx, s.Identifier(), inngest.TriggerName)
+func checkRunningRuns(t *testing.T, m state.Manager) {
+	lister, ok := m.(state.RunLister)
+	if !ok {
+		t.Skip("state store does not implement state.RunLister")
+	}
+
+	ctx := context.Background()
+	wid := uuid.New()
+	from := time.Now().Add(-time.Second)
+
+	ids := []state.Identifier{}
+	for i := 0; i < 3; i++ {
+		runID := ulid.MustNew(ulid.Now(), rand.Reader)
+		id := state.Identifier{
+			WorkflowID: wid,
+			RunID:      runID,
+			Key:        runID.String(),
+		}
+		_, err := m.New(ctx, state.Input{
+			Identifier: id,
+			Workflow:   w,
+			EventData:  input.Map(),
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// Runs of other functions are never listed.
+	other := ulid.MustNew(ulid.Now(), rand.Reader)
+	_, err := m.New(ctx, state.Input{
+		Identifier: state.Identifier{WorkflowID: uuid.New(), RunID: other, Key: other.String()},
+		Workflow:   w,
+		EventData:  input.Map(),
+	})
 	require.NoError(t, err)
 
-	// Pause should be deleted.
-	iter, err = m.PausesByEvent(ctx, evt)
+	// Cancelled runs are no longer running.
+	err = m.Cancel(ctx, ids[0], state.HistoryFunctionCancelled{Type: enums.CancellationTypeManual})
 	require.NoError(t, err)
-	require.Nil(t, iter)
-	found, err = m.PauseByID(ctx, pause.ID)
-	require.Equal(t, state.ErrPauseNotFound, err)
-	require.Nil(t, found)
+
+	to := time.Now().Add(time.Second)
+	found, err := lister.RunningRuns(ctx, wid, from, to)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(found))
+	require.ElementsMatch(t, []ulid.ULID{ids[1].RunID, ids[2].RunID}, []ulid.ULID{found[0].RunID, found[1].RunID})
+
+	// Runs started outside of the range are not listed.
+	found, err = lister.RunningRuns(ctx, wid, to, to.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(found))
+}
+
+func checkCancelDeletesPauses(t *testing.T, m state.Manager) {
+	ctx := context.Background()
+	s := setup(t, m)
+	other := setup(t, m)
+
+	pauses := savePausesForRun(t, m, s, "event/cancel-deletes-pauses")
+	kept := savePausesForRun(t, m, other, "event/cancel-deletes-pauses")
+
+	err := m.Cancel(ctx, s.Identifier(), state.HistoryFunctionCancelled{Type: enums.CancellationTypeManual})
+	require.NoError(t, err)
+
+	requirePausesDeleted(t, m, "event/cancel-deletes-pauses", pauses, kept)
+}
+
+// savePausesForRun saves a cancellation pause, an indexed pause, and a step
+// pause for the given run.
+func savePausesForRun(t *testing.T, m state.Manager, s state.State, evt string) []state.Pause {
+	ctx := context.Background()
+	key := fmt.Sprintf("data.id=%q", s.RunID())
+
+	pauses := []state.Pause{
+		{
+			ID:         uuid.New(),
+			Identifier: s.Identifier(),
+			Expires:    state.Time(time.Now().Add(time.Minute)),
+			Event:      &evt,
+			Cancel:     true,
+		},
+		{
+			ID:         uuid.New(),
+			Identifier: s.Identifier(),
+			Outgoing:   inngest.TriggerName,
+			Incoming:   w.Steps[0].ID,
+			Expires:    state.Time(time.Now().Add(time.Minute)),
+			Event:      &evt,
+			MatchKey:   &key,
+		},
+		{
+			ID:         uuid.New(),
+			Identifier: s.Identifier(),
+			Outgoing:   w.Steps[0].ID,
+			Incoming:   w.Steps[1].ID,
+			Expires:    state.Time(time.Now().Add(time.Minute)),
+		},
+	}
+	for _, p := range pauses {
+		err := m.SavePause(ctx, p)
+		require.NoError(t, err)
+	}
+	return pauses
+}
+
+// requirePausesDeleted asserts that the deleted pauses can no longer be loaded,
+// and that the kept pauses remain.
+func requirePausesDeleted(t *testing.T, m state.Manager, evt string, deleted, kept []state.Pause) {
+	ctx := context.Background()
+
+	for _, p := range deleted {
+		found, err := m.PauseByID(ctx, p.ID)
+		require.Equal(t, state.ErrPauseNotFound, err)
+		require.Nil(t, found)
+
+		_, err = m.PauseByStep(ctx, p.Identifier, p.Incoming)
+		require.Equal(t, state.ErrPauseNotFound, err)
+
+		err = m.LeasePause(ctx, p.ID)
+		require.Equal(t, state.ErrPauseNotFound, err)
+	}
+
+	iter, err := m.PausesByEvent(ctx, uuid.UUID{}, evt)
+	require.NoError(t, err)
+	seen := pauseIDs(ctx, iter)
+
+	keys := []string{}
+	for _, p := range append(deleted, kept...) {
+		if p.MatchKey != nil {
+			keys = append(keys, *p.MatchKey)
+		}
+	}
+	iter, err = m.PausesByEventMatch(ctx, uuid.UUID{}, evt, keys)
+	require.NoError(t, err)
+	seenMatch := pauseIDs(ctx, iter)
+
+	for _, p := range deleted {
+		require.NotContains(t, seen, p.ID)
+		require.NotContains(t, seenMatch, p.ID)
+	}
+	for _, p := range kept {
+		found, err := m.PauseByID(ctx, p.ID)
+		require.NoError(t, err)
+		require.Equal(t, p.ID, found.ID)
+		if p.Event != nil {
+			require.Contains(t, seen, p.ID)
+			require.Contains(t, seenMatch, p.ID)
+		}
+	}
 }
-*/
 
 func setup(t *testing.T, m state.Manager) state.State {
 	ctx := context.Background()
@@ -0,0 +1,132 @@
+package expressions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/parser"
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// Equality represents an equality comparison between an attribute of a given
+// root variable and an expression which doesn't reference the root.
+//
+// For example, within "async.data.id == event.data.id" the attribute of the
+// "async" root is "data.id", compared with the "event.data.id" expression.
+type Equality struct {
+	// Path is the attribute's path, excluding the root, eg. ["data", "id"].
+	Path []string
+	// Expression is the expression that the attribute is compared with.
+	Expression string
+}
+
+// Equalities returns the equality comparisons for attributes of the given root
+// which must hold for the expression to evaluate to true.  Only comparisons
+// joined to the top of the expression via "&&" are returned;  comparisons within
+// "||" or negations may not hold when the expression is true.
+//
+// This allows callers to index expressions by the value that an attribute must
+// equal, eg. to find all expressions that may match a given event.
+func Equalities(ctx context.Context, expression string, root string) ([]Equality, error) {
+	e, err := env()
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := e.Compile(expression)
+	if issues != nil {
+		return nil, fmt.Errorf("error compiling expression: %w", issues.Err())
+	}
+
+	eqs := []Equality{}
+	stack := []*expr.Expr{ast.Expr()}
+	for len(stack) > 0 {
+		node := stack[0]
+		stack = stack[1:]
+
+		call := node.GetCallExpr()
+		if call == nil || len(call.Args) != 2 {
+			continue
+		}
+
+		switch call.Function {
+		case operators.LogicalAnd:
+			stack = append(stack, call.Args...)
+		case operators.Equals:
+			for n, arg := range call.Args {
+				path, ok := selectPath(arg, root)
+				if !ok {
+					continue
+				}
+				other := call.Args[1-n]
+				if references(other, root) {
+					continue
+				}
+				str, err := parser.Unparse(other, ast.SourceInfo())
+				if err != nil {
+					return nil, fmt.Errorf("error unparsing expression: %w", err)
+				}
+				eqs = append(eqs, Equality{Path: path, Expression: str})
+				break
+			}
+		}
+	}
+
+	return eqs, nil
+}
+
+// selectPath returns the fields selected from the given root if the expression
+// is a chain of field selections, eg. "async.data.id".
+func selectPath(e *expr.Expr, root string) ([]string, bool) {
+	path := []string{}
+	for e.GetSelectExpr() != nil {
+		sel := e.GetSelectExpr()
+		if sel.TestOnly {
+			// has() macros return booleans rather than the attribute.
+			return nil, false
+		}
+		path = append([]string{sel.Field}, path...)
+		e = sel.Operand
+	}
+	ident := e.GetIdentExpr()
+	if ident == nil || ident.Name != root || len(path) == 0 {
+		return nil, false
+	}
+	return path, true
+}
+
+// references returns whether the expression references the given root.  Unknown
+// expression kinds are assumed to reference the root.
+func references(e *expr.Expr, root string) bool {
+	stack := []*expr.Expr{e}
+	for len(stack) > 0 {
+		node := stack[0]
+		stack = stack[1:]
+		if node == nil {
+			continue
+		}
+
+		switch kind := node.ExprKind.(type) {
+		case *expr.Expr_ConstExpr:
+		case *expr.Expr_IdentExpr:
+			if kind.IdentExpr.Name == root {
+				return true
+			}
+		case *expr.Expr_SelectExpr:
+			stack = append(stack, kind.SelectExpr.Operand)
+		case *expr.Expr_CallExpr:
+			stack = append(stack, kind.CallExpr.Target)
+			stack = append(stack, kind.CallExpr.Args...)
+		case *expr.Expr_ListExpr:
+			stack = append(stack, kind.ListExpr.Elements...)
+		case *expr.Expr_StructExpr:
+			for _, entry := range kind.StructExpr.Entries {
+				stack = append(stack, entry.GetMapKey(), entry.Value)
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package expressions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEqualities(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected []Equality
+	}{
+		{
+			expr: `async.data.id == "abc"`,
+			expected: []Equality{
+				{Path: []string{"data", "id"}, Expression: `"abc"`},
+			},
+		},
+		{
+			expr: `event.data.id == async.data.id`,
+			expected: []Equality{
+				{Path: []string{"data", "id"}, Expression: `event.data.id`},
+			},
+		},
+		{
+			expr: `async.data.id == event.data.id && async.data.count == 2 && async.data.ok`,
+			expected: []Equality{
+				{Path: []string{"data", "id"}, Expression: `event.data.id`},
+				{Path: []string{"data", "count"}, Expression: `2`},
+			},
+		},
+		{
+			expr: `async.data.name == lowercase(event.data.name)`,
+			expected: []Equality{
+				{Path: []string{"data", "name"}, Expression: `lowercase(event.data.name)`},
+			},
+		},
+		{
+			// Either side of an "||" may be false when the expression is true.
+			expr:     `async.data.id == "abc" || async.data.id == "def"`,
+			expected: []Equality{},
+		},
+		{
+			expr:     `!(async.data.id == "abc")`,
+			expected: []Equality{},
+		},
+		{
+			expr:     `async.data.id != "abc"`,
+			expected: []Equality{},
+		},
+		{
+			// Both sides reference the root.
+			expr:     `async.data.id == async.data.other`,
+			expected: []Equality{},
+		},
+		{
+			expr:     `async.data.ids.exists(x, x == event.data.id)`,
+			expected: []Equality{},
+		},
+		{
+			expr:     `async == event`,
+			expected: []Equality{},
+		},
+	}
+
+	for _, test := range tests {
+		eqs, err := Equalities(context.Background(), test.expr, "async")
+		require.NoError(t, err, test.expr)
+		require.ElementsMatch(t, test.expected, eqs, test.expr)
+	}
+
+	_, err := Equalities(context.Background(), `async.data.id ==`, "async")
+	require.Error(t, err)
+}
@@ -239,6 +239,37 @@ func TestUnmarshal(t *testing.T) {
 				dir: filepath.FromSlash("/dir"),
 			},
 		},
+		{
+			name:  "json definition with a batch trigger",
+			input: `{"id":"wut", "name":"test", triggers: [{ "event": "test.event", "batch": { "maxSize": 100, "timeout": "5s" } }] }`,
+			expected: Function{
+				Name: "test",
+				ID:   "wut",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{
+						Event: "test.event",
+						Batch: &inngest.EventBatch{MaxSize: 100, Timeout: "5s"},
+					}},
+				},
+				Steps: map[string]Step{
+					DefaultStepName: {
+						ID:   DefaultStepName,
+						Name: "test",
+						Path: "file://.",
+						Runtime: &inngest.RuntimeWrapper{
+							Runtime: inngest.RuntimeDocker{},
+						},
+						After: []After{
+							{
+								Step: inngest.TriggerName,
+							},
+						},
+						Version: version11,
+					},
+				},
+				dir: filepath.FromSlash("/dir"),
+			},
+		},
 		{
 			name: "simplest json defintion with step version constraints",
 			input: `{
@@ -338,6 +369,61 @@ func TestUnmarshal(t *testing.T) {
 				dir: filepath.FromSlash("/dir"),
 			},
 		},
+		{
+			name: "json defintion with a step retry backoff",
+			input: `{
+				"id": "wut",
+				"name": "test",
+				"triggers": [{ "event": "test.event" }],
+				"steps": {
+					"step-1": {
+						"id": "step-1",
+						"path": "file://.",
+						"name": "test",
+						"runtime": { "type": "docker" },
+						"after": [
+							{
+								"step": "$trigger"
+							}
+						],
+						"retries": {
+							"attempts": 1,
+							"backoff": { "type": "schedule", "schedule": ["10s", "1m"] }
+						}
+					}
+				}
+			}`,
+			expected: Function{
+				Name: "test",
+				ID:   "wut",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "test.event"}},
+				},
+				Steps: map[string]Step{
+					DefaultStepName: {
+						ID:   DefaultStepName,
+						Name: "test",
+						Path: "file://.",
+						Runtime: &inngest.RuntimeWrapper{
+							Runtime: inngest.RuntimeDocker{},
+						},
+						After: []After{
+							{
+								Step: inngest.TriggerName,
+							},
+						},
+						Retries: &inngest.RetryOptions{
+							Attempts: &int1,
+							Backoff: &inngest.Backoff{
+								Type:     "schedule",
+								Schedule: []string{"10s", "1m"},
+							},
+						},
+					},
+				},
+				dir: filepath.FromSlash("/dir"),
+			},
+		},
 		{
 			name: "simplest plain cue definition",
 			input: `
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	petname "github.com/dustinkirkland/golang-petname"
 	"github.com/google/uuid"
@@ -60,9 +61,21 @@ type Function struct {
 	// key.
 	Idempotency *string `json:"idempotency,omitempty"`
 
+	// IdempotencyPeriod optionally overrides the period for which idempotency keys are
+	// held, eg. "1h".  If nil, this defaults to 24 hours.
+	IdempotencyPeriod *string `json:"idempotencyPeriod,omitempty"`
+
 	// Throttle allows specifying custom throttling for the function.
 	Throttle *inngest.Throttle `json:"throttle,omitempty"`
 
+	// Concurrency allows limiting the number of steps for the function which
+	// run at the same time, optionally for each evaluated key.
+	Concurrency *inngest.Concurrency `json:"concurrency,omitempty"`
+
+	// Debounce delays runs until matching events stop being received for the
+	// debounce period, running once with the last event.
+	Debounce *inngest.Debounce `json:"debounce,omitempty"`
+
 	// Actions represents the actions to take for this function.  If empty, this assumes
 	// that we have a single action specified in the current directory using
 	Steps map[string]Step `json:"steps,omitempty"`
@@ -70,6 +83,9 @@ type Function struct {
 	// Cancel specifies cancellation signals for the function
 	Cancel []Cancel `json:"cancel,omitempty"`
 
+	// Timeouts cancels runs which exceed the given durations.
+	Timeouts *inngest.Timeouts `json:"timeouts,omitempty"`
+
 	// dir is an internal field which maps the root directory for the function
 	dir string
 }
@@ -90,8 +106,11 @@ type After struct {
 	// event data.
 	Async *inngest.AsyncEdgeMetadata `json:"async,omitempty"`
 
-	// TODO: support multiple steps all finishing prior to running this once.
-	// Steps []string `json:"steps,omitempty"`
+	// Steps represents a list of steps to run after.  This step runs once
+	// every step in the list has finished, or has been skipped.  Steps
+	// cannot be used with Step, If, or Async, and each step in the list (and
+	// every step before it) must run after a single step or join.
+	Steps []string `json:"steps,omitempty"`
 }
 
 // Cancel represents a cancellation signal for a function.  When specified, this
@@ -160,6 +179,53 @@ func (f Function) Validate(ctx context.Context) error {
 		}
 	}
 
+	if f.IdempotencyPeriod != nil {
+		if _, perr := str2duration.ParseDuration(*f.IdempotencyPeriod); perr != nil {
+			err = multierror.Append(err, fmt.Errorf("The idempotency period is invalid: %w", perr))
+		}
+	}
+
+	if f.Throttle != nil {
+		// Throttles are enforced by the queue in whole seconds.
+		period, perr := str2duration.ParseDuration(f.Throttle.Period)
+		if perr != nil {
+			err = multierror.Append(err, fmt.Errorf("The throttle period is invalid: %w", perr))
+		} else if period < time.Second {
+			err = multierror.Append(err, fmt.Errorf("The throttle period must be at least 1s"))
+		} else if period%time.Second != 0 {
+			err = multierror.Append(err, fmt.Errorf("The throttle period must be a whole number of seconds"))
+		}
+	}
+
+	if f.Debounce != nil {
+		if _, perr := str2duration.ParseDuration(f.Debounce.Period); perr != nil {
+			err = multierror.Append(err, fmt.Errorf("The debounce period is invalid: %w", perr))
+		}
+		if f.Debounce.Key != nil {
+			if _, kerr := expressions.NewExpressionEvaluator(ctx, *f.Debounce.Key); kerr != nil {
+				err = multierror.Append(err, fmt.Errorf("The debounce key is invalid: %w", kerr))
+			}
+		}
+		for _, t := range f.Triggers {
+			if t.EventTrigger != nil && t.EventTrigger.Batch != nil {
+				err = multierror.Append(err, fmt.Errorf("A function cannot use both debounce and batch triggers"))
+			}
+		}
+	}
+
+	if f.Timeouts != nil {
+		if f.Timeouts.Run != nil {
+			if _, perr := str2duration.ParseDuration(*f.Timeouts.Run); perr != nil {
+				err = multierror.Append(err, fmt.Errorf("The run timeout is invalid: %w", perr))
+			}
+		}
+		if f.Timeouts.Start != nil {
+			if _, perr := str2duration.ParseDuration(*f.Timeouts.Start); perr != nil {
+				err = multierror.Append(err, fmt.Errorf("The start timeout is invalid: %w", perr))
+			}
+		}
+	}
+
 	for k, step := range f.Steps {
 		if k == "" || step.ID == "" {
 			return fmt.Errorf("A step must have an ID defined")
@@ -172,6 +238,38 @@ func (f Function) Validate(ctx context.Context) error {
 		if slug.Make(id) != id {
 			err = multierror.Append(err, fmt.Errorf("A step ID must contain lowercase letters, numbers, and dashes only (eg. 'my-greatest-function-ef81b2')"))
 		}
+		if step.Retries != nil && step.Retries.Backoff != nil {
+			if _, berr := step.Retries.Backoff.Policy(); berr != nil {
+				err = multierror.Append(err, fmt.Errorf("Step '%s' has an invalid retry backoff: %w", step.ID, berr))
+			}
+		}
+		if step.Timeout != nil {
+			if _, terr := str2duration.ParseDuration(*step.Timeout); terr != nil {
+				err = multierror.Append(err, fmt.Errorf("Step '%s' has an invalid timeout: %w", step.ID, terr))
+			}
+		}
+		for _, after := range step.After {
+			if len(after.Steps) == 0 {
+				continue
+			}
+			if after.Step != "" || after.If != "" || after.Async != nil {
+				err = multierror.Append(err, fmt.Errorf("Step '%s' cannot use step, if, or async with a list of steps to run after", step.ID))
+			}
+			seen := map[string]bool{}
+			for _, parent := range after.Steps {
+				if seen[parent] {
+					err = multierror.Append(err, fmt.Errorf("Step '%s' lists step '%s' to run after more than once", step.ID, parent))
+				}
+				seen[parent] = true
+			}
+		}
+		if step.Runtime != nil {
+			if rt, ok := step.Runtime.Runtime.(inngest.RuntimeHTTP); ok {
+				if _, _, terr := rt.Timeouts(); terr != nil {
+					err = multierror.Append(err, fmt.Errorf("Step '%s' has an invalid HTTP timeout: %w", step.ID, terr))
+				}
+			}
+		}
 	}
 
 	_, edges, aerr := f.Actions(ctx)
@@ -180,6 +278,10 @@ func (f Function) Validate(ctx context.Context) error {
 		return err
 	}
 
+	if jerr := validateJoins(edges); jerr != nil {
+		err = multierror.Append(err, jerr)
+	}
+
 	// Validate edges exist.
 	for _, edge := range edges {
 		_, incoming := f.Steps[edge.Incoming]
@@ -215,6 +317,48 @@ func (f Function) Validate(ctx context.Context) error {
 	return err
 }
 
+// validateJoins ensures that every step which runs before a join either runs or
+// is skipped exactly once.  Steps with many parents may still run via another
+// parent when one of their edges is skipped, so the join could never be
+// reported;  these steps can't run before a join.
+func validateJoins(edges []inngest.Edge) error {
+	to := map[string][]inngest.Edge{}
+	for _, edge := range edges {
+		to[edge.Incoming] = append(to[edge.Incoming], edge)
+	}
+
+	var err error
+	seen := map[string]bool{}
+	for _, edge := range edges {
+		if edge.Metadata == nil || len(edge.Metadata.Join) == 0 {
+			continue
+		}
+		stack := []string{edge.Outgoing}
+		for len(stack) > 0 {
+			id := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if id == inngest.TriggerName || seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			parents := to[id]
+			if len(parents) > 0 && parents[0].Metadata != nil && len(parents[0].Metadata.Join) > 0 {
+				// Joins always run once each of their steps finish or are skipped.
+				continue
+			}
+			if len(parents) > 1 {
+				err = multierror.Append(err, fmt.Errorf("Step '%s' runs before a join and cannot run after more than one step", id))
+				continue
+			}
+			for _, parent := range parents {
+				stack = append(stack, parent.Outgoing)
+			}
+		}
+	}
+	return err
+}
+
 // Workflow produces the workflow.cue definition for a function.  Our executor
 // runs a "workflow", which is a DAG of the function steps.  Its a subset of
 // the function used purely for execution.
@@ -234,6 +378,7 @@ func (f Function) Workflow(ctx context.Context) (*inngest.Workflow, error) {
 			w.Triggers[n].EventTrigger = &inngest.EventTrigger{
 				Event:      t.EventTrigger.Event,
 				Expression: t.EventTrigger.Expression,
+				Batch:      t.EventTrigger.Batch,
 			}
 			continue
 		}
@@ -250,6 +395,18 @@ func (f Function) Workflow(ctx context.Context) (*inngest.Workflow, error) {
 		w.Throttle = f.Throttle
 	}
 
+	if f.Concurrency != nil {
+		w.Concurrency = f.Concurrency
+	}
+
+	if f.Debounce != nil {
+		w.Debounce = f.Debounce
+	}
+
+	if f.Timeouts != nil {
+		w.Timeouts = f.Timeouts
+	}
+
 	if f.Idempotency != nil {
 		w.Throttle = &inngest.Throttle{
 			Key:    f.Idempotency,
@@ -290,6 +447,7 @@ func (f Function) Workflow(ctx context.Context) (*inngest.Workflow, error) {
 			Name:     a.Name,
 			DSN:      a.DSN,
 			Retries:  a.Retries,
+			Timeout:  found.Timeout,
 		}
 
 		if a.Version != nil {
@@ -343,6 +501,22 @@ func (f Function) Actions(ctx context.Context) ([]inngest.ActionVersion, []innge
 
 		// For each of the "after" items, add an edge.
 		for _, after := range step.After {
+			if len(after.Steps) > 0 {
+				// Add an edge from each step within the join, each of which
+				// lists every step that must finish before the join runs.
+				for _, parent := range after.Steps {
+					edges = append(edges, inngest.Edge{
+						Outgoing: parent,
+						Incoming: step.ID,
+						Metadata: &inngest.EdgeMetadata{
+							Wait: after.Wait,
+							Join: after.Steps,
+						},
+					})
+				}
+				continue
+			}
+
 			var metadata *inngest.EdgeMetadata
 			if after.Async != nil || after.Wait != nil || after.If != "" {
 				metadata = &inngest.EdgeMetadata{
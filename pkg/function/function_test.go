@@ -77,6 +77,145 @@ func TestValidate(t *testing.T) {
 			},
 			err: fmt.Errorf("undeclared reference to 'lol'"),
 		},
+		// Invalid batch timeout
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{
+						EventTrigger: &EventTrigger{
+							Event: "lol",
+							Batch: &inngest.EventBatch{
+								MaxSize: 10,
+								Timeout: "soon",
+							},
+						},
+					},
+				},
+			},
+			err: fmt.Errorf("The batch timeout is invalid"),
+		},
+		// Invalid debounce period
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Debounce: &inngest.Debounce{Period: "later"},
+			},
+			err: fmt.Errorf("The debounce period is invalid"),
+		},
+		// Invalid throttles
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Throttle: &inngest.Throttle{Count: 1, Period: "later"},
+			},
+			err: fmt.Errorf("The throttle period is invalid"),
+		},
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Throttle: &inngest.Throttle{Count: 1, Period: "500ms"},
+			},
+			err: fmt.Errorf("The throttle period must be at least 1s"),
+		},
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Throttle: &inngest.Throttle{Count: 1, Period: "1.5s"},
+			},
+			err: fmt.Errorf("The throttle period must be a whole number of seconds"),
+		},
+		// Invalid timeouts
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Timeouts: &inngest.Timeouts{Run: strptr("eventually")},
+			},
+			err: fmt.Errorf("The run timeout is invalid"),
+		},
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Timeouts: &inngest.Timeouts{Start: strptr("eventually")},
+			},
+			err: fmt.Errorf("The start timeout is invalid"),
+		},
+		// Invalid step timeout
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Steps: map[string]Step{
+					"id": {
+						ID:   "id",
+						Path: "file://.",
+						Name: "lol",
+						Runtime: &inngest.RuntimeWrapper{
+							Runtime: inngest.RuntimeHTTP{
+								URL:            "https://www.example.com",
+								ConnectTimeout: strptr("quickly"),
+							},
+						},
+						Timeout: strptr("eventually"),
+					},
+				},
+			},
+			err: fmt.Errorf("Step 'id' has an invalid timeout"),
+		},
+		// Invalid retry backoff
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Steps: map[string]Step{
+					"id": {
+						ID:   "id",
+						Path: "file://.",
+						Name: "lol",
+						Runtime: &inngest.RuntimeWrapper{
+							Runtime: inngest.RuntimeHTTP{
+								URL: "https://www.example.com",
+							},
+						},
+						Retries: &inngest.RetryOptions{
+							Backoff: &inngest.Backoff{Type: "fixed"},
+						},
+					},
+				},
+			},
+			err: fmt.Errorf("a fixed backoff must specify an interval"),
+		},
 		// Invalid cron
 		{
 			f: Function{
@@ -147,6 +286,68 @@ func TestValidate(t *testing.T) {
 			},
 			err: nil,
 		},
+		// Joins
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Steps: joinSteps(After{Steps: []string{"a", "b"}}),
+			},
+			err: nil,
+		},
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Steps: joinSteps(After{Steps: []string{"a", "b"}, If: "event.data.ok"}),
+			},
+			err: fmt.Errorf("cannot use step, if, or async with a list of steps"),
+		},
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Steps: joinSteps(After{Steps: []string{"a", "a"}}),
+			},
+			err: fmt.Errorf("lists step 'a' to run after more than once"),
+		},
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Steps: joinSteps(After{Steps: []string{"a", "missing"}}),
+			},
+			err: fmt.Errorf("unknown step 'missing'"),
+		},
+		{
+			f: Function{
+				Name: "Foo",
+				ID:   "well-hello",
+				Triggers: []Trigger{
+					{EventTrigger: &EventTrigger{Event: "lol"}},
+				},
+				Steps: func() map[string]Step {
+					steps := joinSteps(After{Steps: []string{"a", "b"}})
+					a := steps["a"]
+					a.After = []After{{Step: inngest.TriggerName}, {Step: "b"}}
+					steps["a"] = a
+					return steps
+				}(),
+			},
+			err: fmt.Errorf("Step 'a' runs before a join and cannot run after more than one step"),
+		},
 	}
 
 	ctx := context.Background()
@@ -367,6 +568,58 @@ func TestFunctionActions_single(t *testing.T) {
 	require.Equal(t, "single", edges[0].Incoming)
 }
 
+func TestFunctionActions_join(t *testing.T) {
+	fn := Function{
+		ID:   "hi",
+		Name: "test",
+		Triggers: []Trigger{{
+			EventTrigger: &EventTrigger{
+				Event: "test/foo.bar",
+			},
+		}},
+		Steps: joinSteps(After{Steps: []string{"a", "b"}, Wait: strptr("1h")}),
+	}
+	err := fn.Validate(context.Background())
+	require.NoError(t, err)
+
+	_, edges, err := fn.Actions(context.Background())
+	require.NoError(t, err)
+
+	joins := []inngest.Edge{}
+	for _, edge := range edges {
+		if edge.Incoming == "join" {
+			joins = append(joins, edge)
+		}
+	}
+	require.Equal(t, 2, len(joins))
+	for n, parent := range []string{"a", "b"} {
+		require.Equal(t, parent, joins[n].Outgoing)
+		require.NotNil(t, joins[n].Metadata)
+		require.Equal(t, []string{"a", "b"}, joins[n].Metadata.Join)
+		require.Equal(t, "1h", *joins[n].Metadata.Wait)
+	}
+}
+
+func joinSteps(after After) map[string]Step {
+	steps := map[string]Step{}
+	for _, id := range []string{"a", "b", "join"} {
+		steps[id] = Step{
+			ID:   id,
+			Path: "file://.",
+			Name: id,
+			Runtime: &inngest.RuntimeWrapper{
+				Runtime: inngest.RuntimeHTTP{
+					URL: "https://www.example.com",
+				},
+			},
+		}
+	}
+	join := steps["join"]
+	join.After = []After{after}
+	steps["join"] = join
+	return steps
+}
+
 func TestDeterministicUUID(t *testing.T) {
 	tests := []struct {
 		f Function
@@ -29,6 +29,8 @@ type Step struct {
 	After   []After                    `json:"after,omitempty"`
 	Version *inngest.VersionConstraint `json:"version,omitempty"`
 	Retries *inngest.RetryOptions      `json:"retries,omitempty"`
+	// Timeout is the maximum duration of each attempt of the step, eg. "30s".
+	Timeout *string `json:"timeout,omitempty"`
 }
 
 func (s Step) DSN(ctx context.Context, f Function) string {
@@ -9,9 +9,11 @@ import (
 
 	"cuelang.org/go/cue"
 	"github.com/inngest/event-schemas/pkg/fakedata"
+	"github.com/inngest/inngest/inngest"
 	"github.com/inngest/inngest/pkg/event"
 	"github.com/inngest/inngest/pkg/expressions"
 	cron "github.com/robfig/cron/v3"
+	"github.com/xhit/go-str2duration/v2"
 )
 
 // Trigger represents either an event trigger or a cron trigger.  Only one is valid;  when
@@ -49,6 +51,10 @@ type EventTrigger struct {
 
 	// Definition represents the schema or type definition for the event.
 	Definition *EventDefinition `json:"definition,omitempty"`
+
+	// Batch optionally invokes the function with a batch of matching events,
+	// rather than once per event.
+	Batch *inngest.EventBatch `json:"batch,omitempty"`
 }
 
 func (e EventTrigger) TitleName() string {
@@ -78,6 +84,20 @@ func (e EventTrigger) Validate(ctx context.Context) error {
 			return err
 		}
 	}
+	if e.Batch != nil {
+		if e.Batch.MaxSize < 1 {
+			return fmt.Errorf("A batch must have a max size of at least 1")
+		}
+		if _, err := str2duration.ParseDuration(e.Batch.Timeout); err != nil {
+			return fmt.Errorf("The batch timeout is invalid: %w", err)
+		}
+		if e.Batch.Key != nil {
+			if _, err := expressions.NewExpressionEvaluator(ctx, *e.Batch.Key); err != nil {
+				return err
+			}
+		}
+	}
+
 	if e.Definition == nil {
 		// TODO: Warn that we have no event definition
 		return nil
@@ -0,0 +1,63 @@
+// Package metrics provides the root metrics scope used by all services, reported
+// in the prometheus format.
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/inngest/inngest/pkg/logger"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/uber-go/tally/v4"
+	"github.com/uber-go/tally/v4/prometheus"
+)
+
+const (
+	// Prefix is the prefix for all reported metrics.
+	Prefix = "inngest"
+
+	reportInterval = time.Second
+)
+
+var (
+	once     sync.Once
+	reporter prometheus.Reporter
+	scope    tally.Scope
+)
+
+func setup() {
+	once.Do(func() {
+		reporter = prometheus.NewReporter(prometheus.Options{
+			// Use a separate registry to the process' default registry, so that
+			// only metrics reported to the root scope are served.
+			Registerer:       prom.NewRegistry(),
+			DefaultTimerType: prometheus.HistogramTimerType,
+			// Prometheus requires every report of a metric to have the same
+			// tags.  Drop reports which don't, instead of panicking.
+			OnRegisterError: func(err error) {
+				logger.Default().Warn().Err(err).Msg("error registering metric")
+			},
+		})
+		scope, _ = tally.NewRootScope(tally.ScopeOptions{
+			Prefix:          Prefix,
+			CachedReporter:  reporter,
+			Separator:       prometheus.DefaultSeparator,
+			SanitizeOptions: &prometheus.DefaultSanitizerOpts,
+		}, reportInterval)
+	})
+}
+
+// Scope returns the root scope which all services report metrics to.  Each
+// service should use its own sub-scope, eg. Scope().SubScope("queue").
+func Scope() tally.Scope {
+	setup()
+	return scope
+}
+
+// Handler returns an http.Handler which serves all reported metrics in the
+// prometheus text format.
+func Handler() http.Handler {
+	setup()
+	return reporter.HTTPHandler()
+}
@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	scope := Scope().SubScope("test").Tagged(map[string]string{"status": "ok"})
+	scope.Counter("items").Inc(2)
+	scope.Gauge("in_flight").Update(3)
+	scope.Timer("latency").Record(50 * time.Millisecond)
+	// Reports with different tags to the first report of a metric are dropped.
+	require.NotPanics(t, func() {
+		Scope().SubScope("test").Tagged(map[string]string{"kind": "edge"}).Counter("items").Inc(1)
+	})
+
+	// Wait for the root scope to report to prometheus.
+	var body string
+	require.Eventually(t, func() bool {
+		rec := httptest.NewRecorder()
+		Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+		byt, err := io.ReadAll(rec.Body)
+		require.NoError(t, err)
+		body = string(byt)
+
+		return strings.Contains(body, `inngest_test_items{status="ok"} 2`) &&
+			strings.Contains(body, `inngest_test_in_flight{status="ok"} 3`) &&
+			strings.Contains(body, `inngest_test_latency_count{status="ok"} 1`)
+	}, 5*time.Second, 100*time.Millisecond)
+	require.NotContains(t, body, `kind="edge"`)
+}
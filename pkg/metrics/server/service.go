@@ -0,0 +1,62 @@
+// Package server serves all reported metrics over HTTP.
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/inngest/inngest/pkg/config"
+	"github.com/inngest/inngest/pkg/logger"
+	"github.com/inngest/inngest/pkg/metrics"
+	"github.com/inngest/inngest/pkg/service"
+)
+
+// NewService returns a new service which serves all reported metrics via the
+// address and path configured within config.Metrics.
+func NewService(c config.Config) service.Service {
+	return &svc{config: c}
+}
+
+type svc struct {
+	config config.Config
+	server *http.Server
+}
+
+func (s *svc) Name() string {
+	return "metrics"
+}
+
+func (s *svc) Pre(ctx context.Context) error {
+	path := s.config.Metrics.Path
+	if path == "" {
+		path = "/metrics"
+	}
+
+	r := chi.NewRouter()
+	r.Handle(path, metrics.Handler())
+
+	s.server = &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", s.config.Metrics.Addr, s.config.Metrics.Port),
+		Handler: r,
+	}
+	return nil
+}
+
+func (s *svc) Run(ctx context.Context) error {
+	logger.From(ctx).Info().Str("addr", s.server.Addr).Msg("starting metrics server")
+	err := s.server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (s *svc) Stop(ctx context.Context) error {
+	if s.server == nil {
+		return nil
+	}
+	return s.server.Shutdown(ctx)
+}
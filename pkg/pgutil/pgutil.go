@@ -0,0 +1,43 @@
+// Package pgutil connects to the PostgreSQL databases used by the PostgreSQL
+// state store and queue.
+package pgutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	pg "gocloud.dev/postgres"
+)
+
+const (
+	// DefaultURI is the connection URI used when none is provided.
+	DefaultURI = "postgres://localhost:5432/postgres?sslmode=disable"
+
+	// DefaultMaxOpenConns is the default maximum number of open connections
+	// to the database.
+	DefaultMaxOpenConns = 20
+)
+
+// Open connects to the PostgreSQL database at the given URI, using at most
+// maxOpenConns connections.  Empty or zero values use DefaultURI and
+// DefaultMaxOpenConns respectively.
+func Open(ctx context.Context, uri string, maxOpenConns int) (*sql.DB, error) {
+	if uri == "" {
+		uri = DefaultURI
+	}
+	if maxOpenConns <= 0 {
+		maxOpenConns = DefaultMaxOpenConns
+	}
+
+	db, err := pg.Open(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to postgres: %w", err)
+	}
+	db.SetMaxOpenConns(maxOpenConns)
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("error connecting to postgres: %w", err)
+	}
+	return db, nil
+}
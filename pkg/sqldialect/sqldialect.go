@@ -0,0 +1,108 @@
+// Package sqldialect describes the differences between the SQL databases which
+// back the SQL state store and queue, allowing both to share their queries and
+// logic across PostgreSQL and SQLite.
+package sqldialect
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	// Postgres is the dialect used by PostgreSQL, which stores times as
+	// timestamps and uses row and advisory locks to allow many workers to
+	// write concurrently.
+	Postgres = Dialect{
+		Name:       "postgres",
+		ForUpdate:  "FOR UPDATE",
+		SkipLocked: "FOR UPDATE SKIP LOCKED",
+		Lock:       "SELECT pg_advisory_xact_lock($1, hashtext($2))",
+		TryLock:    "SELECT pg_try_advisory_xact_lock($1, hashtext($2))",
+	}
+
+	// SQLite is the dialect used by SQLite, which stores times as millisecond
+	// epochs.  Each transaction holds the database's write lock, so SQLite
+	// needs no row or advisory locks.
+	SQLite = Dialect{
+		Name:       "sqlite",
+		UnixMilli:  true,
+		JSONArrays: true,
+	}
+)
+
+// Dialect describes how queries differ for a given database.  Statements which
+// are empty aren't supported by the database, and must only be run if they're
+// set.
+type Dialect struct {
+	// Name is the name of the database, used within errors.
+	Name string
+
+	// UnixMilli stores times as millisecond epochs instead of timestamps.
+	UnixMilli bool
+	// JSONArrays passes arrays as JSON-encoded strings instead of native
+	// arrays.
+	JSONArrays bool
+
+	// ForUpdate is the clause which locks selected rows until the transaction
+	// ends.
+	ForUpdate string
+	// SkipLocked is the clause which locks selected rows until the transaction
+	// ends, skipping rows locked by other transactions.
+	SkipLocked string
+	// Lock is the statement which takes an advisory lock on the ($1, $2) pair
+	// of an integer class and a string key until the transaction ends,
+	// waiting for the lock if it's held.
+	Lock string
+	// TryLock is the statement which takes an advisory lock in the same way
+	// as Lock without waiting, selecting whether the lock was taken.
+	TryLock string
+}
+
+// Time returns the value used to store the given time.
+func (d Dialect) Time(t time.Time) any {
+	if d.UnixMilli {
+		return t.UnixMilli()
+	}
+	return t
+}
+
+// Array returns the value used to pass the given strings as an array.
+func (d Dialect) Array(s []string) (any, error) {
+	if !d.JSONArrays {
+		return pq.Array(s), nil
+	}
+	byt, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(byt), nil
+}
+
+// In returns a condition which checks whether the column's value is one of the
+// values within the array passed as the given parameter, eg. "$1".
+func (d Dialect) In(column, param string) string {
+	if d.JSONArrays {
+		return fmt.Sprintf("%s IN (SELECT value FROM json_each(%s))", column, param)
+	}
+	return fmt.Sprintf("%s = ANY(%s)", column, param)
+}
+
+// Timestamp scans times stored by any dialect.
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) Scan(src any) error {
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+	case int64:
+		t.Time = time.UnixMilli(v)
+	default:
+		return fmt.Errorf("unable to scan %T as a time", src)
+	}
+	return nil
+}
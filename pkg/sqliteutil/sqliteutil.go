@@ -0,0 +1,71 @@
+// Package sqliteutil opens the SQLite databases used by the SQLite data store,
+// state store and queue.  Each of these can share a single database file.
+package sqliteutil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	// Importing the driver registers it as "sqlite".
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	// DefaultPath is the database file used when none is provided, relative to
+	// the working directory.
+	DefaultPath = "inngest.db"
+
+	// BusyTimeout is the number of milliseconds that connections wait for a
+	// lock held by another connection or process before failing.
+	BusyTimeout = 5000
+)
+
+// Open opens the SQLite database at the given path, creating the database if it
+// doesn't exist, then applies the given schema.  The schema must be idempotent,
+// eg. using "CREATE TABLE IF NOT EXISTS", as it's applied every time the
+// database is opened.
+//
+// Transactions take the database's write lock when they begin, so that
+// concurrent transactions wait for each other instead of failing when
+// upgrading from a read to a write.
+func Open(ctx context.Context, path string, schema string) (*sql.DB, error) {
+	if path == "" {
+		path = DefaultPath
+	}
+	if strings.Contains(path, "?") {
+		return nil, fmt.Errorf("invalid sqlite path: %s", path)
+	}
+
+	dsn := fmt.Sprintf(
+		"%s?_pragma=busy_timeout(%d)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)&_txlock=immediate",
+		path,
+		BusyTimeout,
+	)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error opening sqlite database: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("error opening sqlite database: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("error applying sqlite schema: %w", err)
+	}
+	return db, nil
+}
+
+// IsUniqueViolation returns whether the error was caused by inserting a row
+// which conflicts with an existing row's primary key or unique index.
+func IsUniqueViolation(err error) bool {
+	var serr *sqlite.Error
+	if !errors.As(err, &serr) {
+		return false
+	}
+	return serr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY || serr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
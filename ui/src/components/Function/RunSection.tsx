@@ -210,6 +210,10 @@ const stepEventTypeMap: Record<
     label: "waiting",
     status: EventStatus.Paused,
   },
+  [StepEventType.TimedOut]: {
+    label: "timed out",
+    status: EventStatus.Failed,
+  },
 };
 
 // TODO: Normalize this type in generated.ts
@@ -34,6 +34,16 @@ export type ActionVersionQuery = {
   versionMinor?: InputMaybe<Scalars['Int']>;
 };
 
+export type CancelFunctionRunInput = {
+  functionRunId: Scalars['ID'];
+};
+
+export type CancelFunctionRunsInput = {
+  from: Scalars['Time'];
+  functionId: Scalars['ID'];
+  to?: InputMaybe<Scalars['Time']>;
+};
+
 export type Config = {
   __typename?: 'Config';
   execution?: Maybe<ExecutionConfig>;
@@ -43,6 +53,31 @@ export type CreateActionVersionInput = {
   config: Scalars['String'];
 };
 
+export type DeadLetter = {
+  __typename?: 'DeadLetter';
+  at?: Maybe<Scalars['Time']>;
+  attempt: Scalars['Int'];
+  error: Scalars['String'];
+  failedAt: Scalars['Time'];
+  functionId: Scalars['ID'];
+  functionRunId?: Maybe<Scalars['ID']>;
+  id: Scalars['ID'];
+  kind?: Maybe<Scalars['String']>;
+  payload?: Maybe<Scalars['String']>;
+};
+
+export type DeadLetterQuery = {
+  deadLetterId: Scalars['ID'];
+};
+
+export type DeadLettersQuery = {
+  limit?: InputMaybe<Scalars['Int']>;
+};
+
+export type DeleteQueueItemInput = {
+  queueItemId: Scalars['ID'];
+};
+
 export type DeployFunctionInput = {
   config: Scalars['String'];
   env?: InputMaybe<Scalars['Environment']>;
@@ -99,6 +134,14 @@ export type ExecutionDriversConfig = {
   docker?: Maybe<ExecutionDockerDriverConfig>;
 };
 
+export type Function = {
+  __typename?: 'Function';
+  id: Scalars['ID'];
+  name: Scalars['String'];
+  paused: Scalars['Boolean'];
+  slug: Scalars['String'];
+};
+
 export type FunctionEvent = {
   __typename?: 'FunctionEvent';
   createdAt?: Maybe<Scalars['Time']>;
@@ -112,6 +155,7 @@ export enum FunctionEventType {
   Cancelled = 'CANCELLED',
   Completed = 'COMPLETED',
   Failed = 'FAILED',
+  Skipped = 'SKIPPED',
   Started = 'STARTED'
 }
 
@@ -120,6 +164,7 @@ export type FunctionRun = {
   event?: Maybe<Event>;
   id: Scalars['ID'];
   name?: Maybe<Scalars['String']>;
+  originalRunId?: Maybe<Scalars['ID']>;
   pendingSteps?: Maybe<Scalars['Int']>;
   startedAt?: Maybe<Scalars['Time']>;
   status?: Maybe<FunctionRunStatus>;
@@ -139,7 +184,8 @@ export enum FunctionRunStatus {
   Cancelled = 'CANCELLED',
   Completed = 'COMPLETED',
   Failed = 'FAILED',
-  Running = 'RUNNING'
+  Running = 'RUNNING',
+  Skipped = 'SKIPPED'
 }
 
 export type FunctionRunsQuery = {
@@ -159,34 +205,108 @@ export type FunctionVersion = {
 
 export type Mutation = {
   __typename?: 'Mutation';
+  cancelFunctionRun?: Maybe<Scalars['Boolean']>;
+  cancelFunctionRuns?: Maybe<Scalars['Int']>;
   createActionVersion?: Maybe<ActionVersion>;
+  deleteQueueItem?: Maybe<Scalars['Boolean']>;
   deployFunction?: Maybe<FunctionVersion>;
+  pauseFunction?: Maybe<Function>;
+  purgeDeadLetters?: Maybe<Scalars['Int']>;
+  reprioritizeQueuePartition?: Maybe<Scalars['Boolean']>;
+  requeueDeadLetter?: Maybe<Scalars['Boolean']>;
+  requeueQueueItem?: Maybe<Scalars['Boolean']>;
+  rerunFunctionRun?: Maybe<Scalars['ID']>;
+  resumeFunction?: Maybe<Function>;
   updateActionVersion?: Maybe<ActionVersion>;
 };
 
 
+export type MutationCancelFunctionRunArgs = {
+  input: CancelFunctionRunInput;
+};
+
+
+export type MutationCancelFunctionRunsArgs = {
+  input: CancelFunctionRunsInput;
+};
+
+
 export type MutationCreateActionVersionArgs = {
   input: CreateActionVersionInput;
 };
 
 
+export type MutationDeleteQueueItemArgs = {
+  input: DeleteQueueItemInput;
+};
+
+
 export type MutationDeployFunctionArgs = {
   input: DeployFunctionInput;
 };
 
 
+export type MutationPauseFunctionArgs = {
+  input: PauseFunctionInput;
+};
+
+
+export type MutationPurgeDeadLettersArgs = {
+  input: PurgeDeadLettersInput;
+};
+
+
+export type MutationReprioritizeQueuePartitionArgs = {
+  input: ReprioritizeQueuePartitionInput;
+};
+
+
+export type MutationRequeueDeadLetterArgs = {
+  input: RequeueDeadLetterInput;
+};
+
+
+export type MutationRequeueQueueItemArgs = {
+  input: RequeueQueueItemInput;
+};
+
+
+export type MutationRerunFunctionRunArgs = {
+  input: RerunFunctionRunInput;
+};
+
+
+export type MutationResumeFunctionArgs = {
+  input: ResumeFunctionInput;
+};
+
+
 export type MutationUpdateActionVersionArgs = {
   input: UpdateActionVersionInput;
 };
 
+export type PauseFunctionInput = {
+  functionId: Scalars['ID'];
+};
+
+export type PurgeDeadLettersInput = {
+  deadLetterIds?: InputMaybe<Array<Scalars['ID']>>;
+};
+
 export type Query = {
   __typename?: 'Query';
   actionVersion?: Maybe<ActionVersion>;
   config?: Maybe<Config>;
+  deadLetter?: Maybe<DeadLetter>;
+  deadLetters?: Maybe<Array<DeadLetter>>;
   event?: Maybe<Event>;
   events?: Maybe<Array<Event>>;
   functionRun?: Maybe<FunctionRun>;
   functionRuns?: Maybe<Array<FunctionRun>>;
+  functions?: Maybe<Array<Function>>;
+  queueItems?: Maybe<Array<QueueItem>>;
+  queueLeases?: Maybe<Array<QueueLease>>;
+  queuePartitions?: Maybe<Array<QueuePartition>>;
 };
 
 
@@ -195,6 +315,16 @@ export type QueryActionVersionArgs = {
 };
 
 
+export type QueryDeadLetterArgs = {
+  query: DeadLetterQuery;
+};
+
+
+export type QueryDeadLettersArgs = {
+  query: DeadLettersQuery;
+};
+
+
 export type QueryEventArgs = {
   query: EventQuery;
 };
@@ -214,6 +344,86 @@ export type QueryFunctionRunsArgs = {
   query: FunctionRunsQuery;
 };
 
+
+export type QueryQueueItemsArgs = {
+  query: QueueItemsQuery;
+};
+
+
+export type QueryQueueLeasesArgs = {
+  query: QueueLeasesQuery;
+};
+
+
+export type QueryQueuePartitionsArgs = {
+  query: QueuePartitionsQuery;
+};
+
+export type QueueItem = {
+  __typename?: 'QueueItem';
+  at: Scalars['Time'];
+  attempt: Scalars['Int'];
+  functionId: Scalars['ID'];
+  functionRunId: Scalars['ID'];
+  id: Scalars['ID'];
+  kind: Scalars['String'];
+  leaseId?: Maybe<Scalars['ID']>;
+  payload: Scalars['String'];
+};
+
+export type QueueItemsQuery = {
+  functionId: Scalars['ID'];
+  limit?: InputMaybe<Scalars['Int']>;
+};
+
+export type QueueLease = {
+  __typename?: 'QueueLease';
+  expires: Scalars['Time'];
+  id?: Maybe<Scalars['ID']>;
+  kind: Scalars['String'];
+  leaseId: Scalars['ID'];
+};
+
+export type QueueLeasesQuery = {
+  limit?: InputMaybe<Scalars['Int']>;
+};
+
+export type QueuePartition = {
+  __typename?: 'QueuePartition';
+  at: Scalars['Time'];
+  functionId: Scalars['ID'];
+  leaseId?: Maybe<Scalars['ID']>;
+  len: Scalars['Int'];
+  priority: Scalars['Int'];
+};
+
+export type QueuePartitionsQuery = {
+  limit?: InputMaybe<Scalars['Int']>;
+};
+
+export type ReprioritizeQueuePartitionInput = {
+  functionId: Scalars['ID'];
+  priority: Scalars['Int'];
+};
+
+export type RequeueDeadLetterInput = {
+  deadLetterId: Scalars['ID'];
+};
+
+export type RequeueQueueItemInput = {
+  at?: InputMaybe<Scalars['Time']>;
+  queueItemId: Scalars['ID'];
+};
+
+export type RerunFunctionRunInput = {
+  fromStep?: InputMaybe<Scalars['String']>;
+  functionRunId: Scalars['ID'];
+};
+
+export type ResumeFunctionInput = {
+  functionId: Scalars['ID'];
+};
+
 export type StepEvent = {
   __typename?: 'StepEvent';
   createdAt?: Maybe<Scalars['Time']>;
@@ -232,6 +442,7 @@ export enum StepEventType {
   Failed = 'FAILED',
   Scheduled = 'SCHEDULED',
   Started = 'STARTED',
+  TimedOut = 'TIMED_OUT',
   Waiting = 'WAITING'
 }
 